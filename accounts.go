@@ -1,5 +1,35 @@
 package egoscale
 
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// Delete removes the given account and all its resources
+func (account *Account) Delete(ctx context.Context, client *Client) error {
+	if account.ID == "" {
+		return fmt.Errorf("an Account may only be deleted using ID")
+	}
+
+	return client.BooleanRequestWithContext(ctx, &DeleteAccount{
+		ID: account.ID,
+	})
+}
+
+// ListRequest builds the ListAccounts request
+func (account *Account) ListRequest() (ListCommand, error) {
+	req := &ListAccounts{
+		AccountType: account.AccountType,
+		DomainID:    account.DomainID,
+		ID:          account.ID,
+		Name:        account.Name,
+		State:       account.State,
+	}
+
+	return req, nil
+}
+
 func (*ListAccounts) name() string {
 	return "listAccounts"
 }
@@ -12,6 +42,30 @@ func (*ListAccounts) response() interface{} {
 	return new(ListAccountsResponse)
 }
 
+// SetPage sets the current page
+func (ls *ListAccounts) SetPage(page int) {
+	ls.Page = page
+}
+
+// SetPageSize sets the page size
+func (ls *ListAccounts) SetPageSize(pageSize int) {
+	ls.PageSize = pageSize
+}
+
+func (*ListAccounts) each(resp interface{}, callback IterateItemFunc) {
+	accounts, ok := resp.(*ListAccountsResponse)
+	if !ok {
+		callback(nil, fmt.Errorf("wrong type. ListAccountsResponse expected, got %T", resp))
+		return
+	}
+
+	for i := range accounts.Account {
+		if !callback(&accounts.Account[i], nil) {
+			break
+		}
+	}
+}
+
 func (*EnableAccount) name() string {
 	return "enableAccount"
 }
@@ -35,3 +89,59 @@ func (*DisableAccount) description() string {
 func (*DisableAccount) asyncResponse() interface{} {
 	return new(Account)
 }
+
+func (*CreateAccount) name() string {
+	return "createAccount"
+}
+
+func (*CreateAccount) description() string {
+	return "Creates an account"
+}
+
+func (*CreateAccount) response() interface{} {
+	return new(Account)
+}
+
+func (req *CreateAccount) onBeforeSend(params *url.Values) error {
+	// The user account type is zero but required
+	if req.AccountType == UserAccount {
+		params.Set("accounttype", "0")
+	}
+	return nil
+}
+
+func (*UpdateAccount) name() string {
+	return "updateAccount"
+}
+
+func (*UpdateAccount) description() string {
+	return "Updates account information for the authenticated user"
+}
+
+func (*UpdateAccount) response() interface{} {
+	return new(Account)
+}
+
+func (*DeleteAccount) name() string {
+	return "deleteAccount"
+}
+
+func (*DeleteAccount) description() string {
+	return "Deletes a account, and all users associated with this account"
+}
+
+func (*DeleteAccount) asyncResponse() interface{} {
+	return new(booleanResponse)
+}
+
+func (*LockAccount) name() string {
+	return "lockAccount"
+}
+
+func (*LockAccount) description() string {
+	return "Locks an account"
+}
+
+func (*LockAccount) response() interface{} {
+	return new(Account)
+}
@@ -1,6 +1,7 @@
 package egoscale
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -27,3 +28,85 @@ func TestDisableAccount(t *testing.T) {
 	}
 	_ = req.asyncResponse().(*Account)
 }
+
+func TestCreateAccount(t *testing.T) {
+	req := &CreateAccount{}
+	if req.name() != "createAccount" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.response().(*Account)
+}
+
+func TestUpdateAccount(t *testing.T) {
+	req := &UpdateAccount{}
+	if req.name() != "updateAccount" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.response().(*Account)
+}
+
+func TestDeleteAccount(t *testing.T) {
+	req := &DeleteAccount{}
+	if req.name() != "deleteAccount" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.asyncResponse().(*booleanResponse)
+}
+
+func TestLockAccount(t *testing.T) {
+	req := &LockAccount{}
+	if req.name() != "lockAccount" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.response().(*Account)
+}
+
+func TestCreateUserAccountPayload(t *testing.T) {
+	cs := NewClient("http://exoscale.local/", "KEY", "SECRET")
+
+	payload, err := cs.Payload(&CreateAccount{
+		AccountType: UserAccount,
+		Email:       "jane@example.org",
+		FirstName:   "Jane",
+		LastName:    "Doe",
+		Password:    "secret",
+		UserName:    "jane",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(payload, "accounttype=0") {
+		t.Errorf("the user account type was expected, got %q", payload)
+	}
+}
+
+func TestGetAccount(t *testing.T) {
+	ts := newServer(response{200, jsonContentType, `
+{"listaccountsresponse": {
+	"count": 1,
+	"account": [
+		{
+			"accounttype": 2,
+			"domain": "reseller",
+			"domainid": "6c1d2f3e-4a5b-4c6d-8e7f-9a0b1c2d3e4f",
+			"id": "b8d15a5c-2e2c-4b0f-9d1b-6c2f3c07e7c1",
+			"name": "reseller-admin",
+			"state": "enabled",
+			"vmtotal": 3
+		}
+	]
+}}`})
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+
+	account := &Account{Name: "reseller-admin"}
+	if err := cs.Get(account); err != nil {
+		t.Fatal(err)
+	}
+
+	if account.AccountType != DomainAdminAccount {
+		t.Errorf("a domain admin was expected, got %s", account.AccountType)
+	}
+}
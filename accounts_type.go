@@ -110,3 +110,49 @@ type DisableAccount struct {
 	DomainID string `json:"domainid,omitempty" doc:"Disables specified account in this domain."`
 	ID       string `json:"id,omitempty" doc:"Account id"`
 }
+
+// CreateAccount represents the creation of an account and its first user
+//
+// CloudStack API: http://cloudstack.apache.org/api/apidocs-4.10/apis/createAccount.html
+type CreateAccount struct {
+	AccountType    AccountType       `json:"accounttype,omitempty" doc:"Type of the account. Specify 0 for user, 1 for root admin, and 2 for domain admin"`
+	Email          string            `json:"email" doc:"email"`
+	FirstName      string            `json:"firstname" doc:"firstname"`
+	LastName       string            `json:"lastname" doc:"lastname"`
+	Password       string            `json:"password" doc:"Clear text password (Default hashed to SHA256SALT). If you wish to use any other hashing algorithm, you would need to write a custom authentication adapter See Docs section."`
+	UserName       string            `json:"username" doc:"Unique username."`
+	Account        string            `json:"account,omitempty" doc:"Creates the user under the specified account. If no account is specified, the username will be used as the account name."`
+	AccountDetails map[string]string `json:"accountdetails,omitempty" doc:"details for account used to store specific parameters"`
+	AccountID      string            `json:"accountid,omitempty" doc:"Account UUID, required for adding account from external provisioning system"`
+	DomainID       string            `json:"domainid,omitempty" doc:"Creates the user under the specified domain."`
+	NetworkDomain  string            `json:"networkdomain,omitempty" doc:"Network domain for the account's networks"`
+	Timezone       string            `json:"timezone,omitempty" doc:"Specifies a timezone for this command. For more information on the timezone parameter, see Time Zone Format."`
+	UserID         string            `json:"userid,omitempty" doc:"User UUID, required for adding account from external provisioning system"`
+}
+
+// UpdateAccount represents the modification of an account
+//
+// CloudStack API: http://cloudstack.apache.org/api/apidocs-4.10/apis/updateAccount.html
+type UpdateAccount struct {
+	NewName        string            `json:"newname" doc:"new name for the account"`
+	Account        string            `json:"account,omitempty" doc:"the current account name"`
+	AccountDetails map[string]string `json:"accountdetails,omitempty" doc:"details for account used to store specific parameters"`
+	DomainID       string            `json:"domainid,omitempty" doc:"the ID of the domain where the account exists"`
+	ID             string            `json:"id,omitempty" doc:"Account id"`
+	NetworkDomain  string            `json:"networkdomain,omitempty" doc:"Network domain for the account's networks; empty string will update domainName with NULL value"`
+}
+
+// DeleteAccount (Async) represents the deletion of an account and all its resources
+//
+// CloudStack API: http://cloudstack.apache.org/api/apidocs-4.10/apis/deleteAccount.html
+type DeleteAccount struct {
+	ID string `json:"id" doc:"Account id"`
+}
+
+// LockAccount represents the locking of an account, its users cannot log in anymore
+//
+// CloudStack API: http://cloudstack.apache.org/api/apidocs-4.10/apis/lockAccount.html
+type LockAccount struct {
+	Account  string `json:"account" doc:"Locks the specified account."`
+	DomainID string `json:"domainid" doc:"Locks the specified account on this domain."`
+}
@@ -21,6 +21,11 @@ func (*IPAddress) ResourceType() string {
 	return "PublicIpAddress"
 }
 
+// ResourceID returns the identifier of the resource
+func (ipaddress *IPAddress) ResourceID() string {
+	return ipaddress.ID
+}
+
 func (*AssociateIPAddress) name() string {
 	return "associateIpAddress"
 }
@@ -82,6 +87,7 @@ func (ipaddress *IPAddress) ListRequest() (ListCommand, error) {
 		IsElastic:           &ipaddress.IsElastic,
 		IsSourceNat:         &ipaddress.IsSourceNat,
 		PhysicalNetworkID:   ipaddress.PhysicalNetworkID,
+		Tags:                tagFilter(ipaddress.Tags),
 		VlanID:              ipaddress.VlanID,
 		ZoneID:              ipaddress.ZoneID,
 	}
@@ -112,3 +118,27 @@ func (*ListPublicIPAddresses) each(resp interface{}, callback IterateItemFunc) {
 		}
 	}
 }
+
+func (*EnableStaticNat) name() string {
+	return "enableStaticNat"
+}
+
+func (*EnableStaticNat) description() string {
+	return "Enables static NAT for given ip address"
+}
+
+func (*EnableStaticNat) response() interface{} {
+	return new(booleanResponse)
+}
+
+func (*DisableStaticNat) name() string {
+	return "disableStaticNat"
+}
+
+func (*DisableStaticNat) description() string {
+	return "Disables static rule for given ip address"
+}
+
+func (*DisableStaticNat) asyncResponse() interface{} {
+	return new(booleanResponse)
+}
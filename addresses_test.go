@@ -226,3 +226,19 @@ func TestListIPAddressFailure(t *testing.T) {
 	}
 
 }
+
+func TestEnableStaticNat(t *testing.T) {
+	req := &EnableStaticNat{}
+	if req.name() != "enableStaticNat" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.response().(*booleanResponse)
+}
+
+func TestDisableStaticNat(t *testing.T) {
+	req := &DisableStaticNat{}
+	if req.name() != "disableStaticNat" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.asyncResponse().(*booleanResponse)
+}
@@ -98,3 +98,20 @@ type ListPublicIPAddressesResponse struct {
 	Count           int         `json:"count"`
 	PublicIPAddress []IPAddress `json:"publicipaddress"`
 }
+
+// EnableStaticNat represents the attachment of a public IP address to a virtual machine
+//
+// CloudStack API: https://cloudstack.apache.org/api/apidocs-4.10/apis/enableStaticNat.html
+type EnableStaticNat struct {
+	IPAddressID      string `json:"ipaddressid" doc:"the public IP address id for which static nat feature is being enabled"`
+	VirtualMachineID string `json:"virtualmachineid" doc:"the ID of the virtual machine for enabling static nat feature"`
+	NetworkID        string `json:"networkid,omitempty" doc:"The network of the vm the static nat will be enabled for. Required when public Ip address is not associated with any Guest network yet (VPC case)"`
+	VMGuestIP        net.IP `json:"vmguestip,omitempty" doc:"VM guest nic Secondary ip address for the port forwarding rule"`
+}
+
+// DisableStaticNat (Async) represents the detachment of a public IP address from its virtual machine
+//
+// CloudStack API: https://cloudstack.apache.org/api/apidocs-4.10/apis/disableStaticNat.html
+type DisableStaticNat struct {
+	IPAddressID string `json:"ipaddressid" doc:"the public IP address id for which static nat feature is being disabled"`
+}
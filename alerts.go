@@ -0,0 +1,39 @@
+package egoscale
+
+import "fmt"
+
+func (*ListAlerts) name() string {
+	return "listAlerts"
+}
+
+func (*ListAlerts) description() string {
+	return "Lists all alerts."
+}
+
+func (*ListAlerts) response() interface{} {
+	return new(ListAlertsResponse)
+}
+
+// SetPage sets the current page
+func (ls *ListAlerts) SetPage(page int) {
+	ls.Page = page
+}
+
+// SetPageSize sets the page size
+func (ls *ListAlerts) SetPageSize(pageSize int) {
+	ls.PageSize = pageSize
+}
+
+func (*ListAlerts) each(resp interface{}, callback IterateItemFunc) {
+	alerts, ok := resp.(*ListAlertsResponse)
+	if !ok {
+		callback(nil, fmt.Errorf("wrong type. ListAlertsResponse expected, got %T", resp))
+		return
+	}
+
+	for i := range alerts.Alert {
+		if !callback(&alerts.Alert[i], nil) {
+			break
+		}
+	}
+}
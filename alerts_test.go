@@ -0,0 +1,13 @@
+package egoscale
+
+import (
+	"testing"
+)
+
+func TestListAlerts(t *testing.T) {
+	req := &ListAlerts{}
+	if req.name() != "listAlerts" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.response().(*ListAlertsResponse)
+}
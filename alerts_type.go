@@ -0,0 +1,28 @@
+package egoscale
+
+// Alert represents an event raised by CloudStack to the administrators
+type Alert struct {
+	Description string `json:"description,omitempty" doc:"description of the alert"`
+	ID          string `json:"id,omitempty" doc:"the id of the alert"`
+	Name        string `json:"name,omitempty" doc:"the name of the alert"`
+	Sent        string `json:"sent,omitempty" doc:"the date and time the alert was sent"`
+	Type        int    `json:"type,omitempty" doc:"One of the following alert types: MEMORY = 0, CPU = 1, STORAGE = 2, STORAGE_ALLOCATED = 3, PUBLIC_IP = 4, PRIVATE_IP = 5, SECONDARY_STORAGE = 6, HOST = 7, USERVM = 8, DOMAIN_ROUTER = 9, CONSOLE_PROXY = 10, ROUTING = 11: lost connection to default route (to the gateway), STORAGE_MISC = 12, USAGE_SERVER = 13, MANAGMENT_NODE = 14, DOMAIN_ROUTER_MIGRATE = 15, CONSOLE_PROXY_MIGRATE = 16, USERVM_MIGRATE = 17, VLAN = 18, SSVM = 19, USAGE_SERVER_RESULT = 20, STORAGE_DELETE = 21, UPDATE_RESOURCE_COUNT = 22, USAGE_SANITY_RESULT = 23, DIRECT_ATTACHED_PUBLIC_IP = 24, LOCAL_STORAGE = 25, RESOURCE_LIMIT_EXCEEDED = 26, SYNC = 27, UPLOAD_FAILED = 28, OOBM_AUTH_ERROR = 29"`
+}
+
+// ListAlerts represents a query to list the alerts
+//
+// CloudStack API: https://cloudstack.apache.org/api/apidocs-4.10/apis/listAlerts.html
+type ListAlerts struct {
+	ID       string `json:"id,omitempty" doc:"the ID of the alert"`
+	Keyword  string `json:"keyword,omitempty" doc:"List by keyword"`
+	Name     string `json:"name,omitempty" doc:"list by alert name"`
+	Page     int    `json:"page,omitempty"`
+	PageSize int    `json:"pagesize,omitempty"`
+	Type     string `json:"type,omitempty" doc:"list by alert type"`
+}
+
+// ListAlertsResponse represents a list of alerts
+type ListAlertsResponse struct {
+	Count int     `json:"count"`
+	Alert []Alert `json:"alert"`
+}
@@ -0,0 +1,107 @@
+package egoscale
+
+import (
+	"context"
+	"strconv"
+)
+
+// defaults of CloudStack, used when the configuration cannot be listed
+const (
+	defaultUserDataMaxLength = 32768
+	defaultMaxPageSize       = 500
+)
+
+// Capabilities fetches the capability and the configurations of the cloud
+//
+// The result is cached by the client, the lock isn't held during the requests
+// so concurrent first calls may both fetch them. The configurations are only
+// visible to the administrators, it is left empty if CloudStack refuses to list them.
+func (client *Client) Capabilities(ctx context.Context) (*Capabilities, error) {
+	client.capabilitiesLock.Lock()
+	cached := client.capabilities
+	client.capabilitiesLock.Unlock()
+
+	if cached != nil {
+		return cached, nil
+	}
+
+	resp, err := client.RequestWithContext(ctx, &ListCapabilities{})
+	if err != nil {
+		return nil, err
+	}
+
+	capabilities := &Capabilities{
+		Capability:     *resp.(*Capability),
+		Configurations: make([]Configuration, 0),
+	}
+
+	client.PaginateWithContext(ctx, &ListConfigurations{}, func(item interface{}, e error) bool {
+		if e != nil {
+			if _, ok := e.(*ErrorResponse); !ok {
+				err = e
+			}
+			return false
+		}
+		capabilities.Configurations = append(capabilities.Configurations, *item.(*Configuration))
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	client.capabilitiesLock.Lock()
+	defer client.capabilitiesLock.Unlock()
+
+	// the first one to finish wins
+	if client.capabilities == nil {
+		client.capabilities = capabilities
+	}
+	return client.capabilities, nil
+}
+
+// Configuration returns the value of the given setting
+func (capabilities *Capabilities) Configuration(name string) (string, bool) {
+	for _, configuration := range capabilities.Configurations {
+		if configuration.Name == name {
+			return configuration.Value, true
+		}
+	}
+	return "", false
+}
+
+// UserDataMaxLength returns the maximum length of the base64 encoded user data
+func (capabilities *Capabilities) UserDataMaxLength() int {
+	return capabilities.intConfiguration("vm.userdata.max.length", defaultUserDataMaxLength)
+}
+
+// MaxPageSize returns the maximum number of items the list commands may return per page
+//
+// Once the capabilities are fetched, the client paginates within this limit.
+func (capabilities *Capabilities) MaxPageSize() int {
+	return capabilities.intConfiguration("default.page.size", defaultMaxPageSize)
+}
+
+func (capabilities *Capabilities) intConfiguration(name string, fallback int) int {
+	value, ok := capabilities.Configuration(name)
+	if !ok {
+		return fallback
+	}
+
+	i, err := strconv.Atoi(value)
+	if err != nil {
+		return fallback
+	}
+	return i
+}
+
+func (*ListCapabilities) name() string {
+	return "listCapabilities"
+}
+
+func (*ListCapabilities) description() string {
+	return "Lists capabilities"
+}
+
+func (*ListCapabilities) response() interface{} {
+	return new(Capability)
+}
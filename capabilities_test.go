@@ -0,0 +1,203 @@
+package egoscale
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+)
+
+const listCapabilitiesResponse = `
+{"listcapabilitiesresponse": {
+	"capability": {
+		"allowusercreateprojects": false,
+		"allowuserexpungerecovervm": true,
+		"allowuserviewdestroyedvm": true,
+		"apilimitinterval": 1,
+		"apilimitmax": 100,
+		"cloudstackversion": "4.10.0.0",
+		"customdiskofferingmaxsize": 1024,
+		"customdiskofferingminsize": 1,
+		"dynamicrolesenabled": false,
+		"kvmsnapshotenabled": false,
+		"projectinviterequired": false,
+		"regionsecondaryenabled": false,
+		"securitygroupsenabled": true,
+		"supportELB": "false",
+		"userpublictemplateenabled": true
+	}
+}}`
+
+func TestListCapabilities(t *testing.T) {
+	req := &ListCapabilities{}
+	if req.name() != "listCapabilities" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.response().(*Capability)
+}
+
+func TestClientCapabilities(t *testing.T) {
+	ts := newServer(
+		response{200, jsonContentType, listCapabilitiesResponse},
+		response{200, jsonContentType, `
+{"listconfigurationsresponse": {
+	"count": 2,
+	"configuration": [
+		{
+			"category": "Advanced",
+			"description": "Max length of vm userdata after base64 decoding.",
+			"isdynamic": false,
+			"name": "vm.userdata.max.length",
+			"value": "8192"
+		},
+		{
+			"category": "Advanced",
+			"description": "Default page size for API list* commands",
+			"isdynamic": false,
+			"name": "default.page.size",
+			"value": "1000"
+		}
+	]
+}}`},
+	)
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+
+	capabilities, err := cs.Capabilities(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if capabilities.CloudStackVersion != "4.10.0.0" || !capabilities.SecurityGroupsEnabled {
+		t.Errorf("bad capability, got %#v", capabilities.Capability)
+	}
+
+	if capabilities.UserDataMaxLength() != 8192 {
+		t.Errorf("bad user data max length, got %d", capabilities.UserDataMaxLength())
+	}
+
+	if capabilities.MaxPageSize() != 1000 {
+		t.Errorf("bad max page size, got %d", capabilities.MaxPageSize())
+	}
+
+	// the server has no more responses, the cache is used
+	cached, err := cs.Capabilities(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if cached != capabilities {
+		t.Errorf("the capabilities were expected to be cached")
+	}
+}
+
+func TestClientCapabilitiesWithoutConfigurations(t *testing.T) {
+	ts := newServer(
+		response{200, jsonContentType, listCapabilitiesResponse},
+		response{432, jsonContentType, `
+{"listconfigurationsresponse": {
+	"cserrorcode": 9999,
+	"errorcode": 432,
+	"errortext": "The given command does not exist or it is not available for user",
+	"uuidList": []
+}}`},
+	)
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+
+	capabilities, err := cs.Capabilities(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(capabilities.Configurations) != 0 {
+		t.Errorf("no configurations were expected, got %d", len(capabilities.Configurations))
+	}
+
+	if capabilities.UserDataMaxLength() != defaultUserDataMaxLength {
+		t.Errorf("bad user data max length, got %d", capabilities.UserDataMaxLength())
+	}
+
+	if capabilities.MaxPageSize() != defaultMaxPageSize {
+		t.Errorf("bad max page size, got %d", capabilities.MaxPageSize())
+	}
+}
+
+func TestClientPaginateWithinMaxPageSize(t *testing.T) {
+	queries := make([]url.Values, 0)
+	ts := newRecordingServer(&queries,
+		response{200, jsonContentType, `
+{"listzonesresponse": {
+	"count": 3,
+	"zone": [{"id": "1"}, {"id": "2"}]
+}}`},
+		response{200, jsonContentType, `
+{"listzonesresponse": {
+	"count": 3,
+	"zone": [{"id": "3"}]
+}}`},
+	)
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+	cs.capabilities = &Capabilities{
+		Configurations: []Configuration{{Name: "default.page.size", Value: "2"}},
+	}
+
+	zones, err := cs.ListWithContext(context.TODO(), &Zone{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(zones) != 3 {
+		t.Errorf("three zones were expected, got %d", len(zones))
+	}
+
+	for _, query := range queries {
+		if query.Get("pagesize") != "2" {
+			t.Errorf("the page size was expected to be clamped, got %v", query)
+		}
+	}
+}
+
+func TestClientCapabilitiesFailure(t *testing.T) {
+	ts := newServer()
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+
+	if _, err := cs.Capabilities(context.TODO()); err == nil {
+		t.Errorf("an error was expected")
+	}
+}
+
+func TestClientCapabilitiesNotSerialized(t *testing.T) {
+	ts := newSleepyServer(500*time.Millisecond, 200, jsonContentType, listCapabilitiesResponse)
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+
+	done := make(chan struct{})
+	go func() {
+		cs.Capabilities(context.Background()) // nolint: errcheck
+		close(done)
+	}()
+
+	// the slow call above doesn't hold the lock while waiting for the cloud
+	time.Sleep(50 * time.Millisecond)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	start := time.Now()
+	if _, err := cs.Capabilities(ctx); err == nil {
+		t.Error("an error was expected, the context is canceled")
+	}
+
+	if elapsed := time.Since(start); elapsed > 250*time.Millisecond {
+		t.Errorf("the second call waited for the first one, %s", elapsed)
+	}
+
+	<-done
+}
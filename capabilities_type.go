@@ -0,0 +1,31 @@
+package egoscale
+
+// Capability represents the features of the cloud
+type Capability struct {
+	AllowUserCreateProjects   bool   `json:"allowusercreateprojects,omitempty" doc:"true if regular user is allowed to create projects"`
+	AllowUserExpungeRecoverVM bool   `json:"allowuserexpungerecovervm,omitempty" doc:"true if the user is allowed to expunge/recover vm"`
+	AllowUserViewDestroyedVM  bool   `json:"allowuserviewdestroyedvm,omitempty" doc:"true if the user is allowed to view destroyed virtualmachines"`
+	APILimitInterval          int    `json:"apilimitinterval,omitempty" doc:"time interval (in seconds) to reset api count"`
+	APILimitMax               int    `json:"apilimitmax,omitempty" doc:"Max allowed number of api requests within the specified interval"`
+	CloudStackVersion         string `json:"cloudstackversion,omitempty" doc:"version of the cloud stack"`
+	CustomDiskOfferingMaxSize int64  `json:"customdiskofferingmaxsize,omitempty" doc:"maximum size that can be specified when create disk from disk offering with custom size"`
+	CustomDiskOfferingMinSize int64  `json:"customdiskofferingminsize,omitempty" doc:"minimum size that can be specified when create disk from disk offering with custom size"`
+	DynamicRolesEnabled       bool   `json:"dynamicrolesenabled,omitempty" doc:"true if dynamic role-based api checker is enabled, false otherwise"`
+	KVMSnapshotEnabled        bool   `json:"kvmsnapshotenabled,omitempty" doc:"true if snapshot is supported for KVM host, false otherwise"`
+	ProjectInviteRequired     bool   `json:"projectinviterequired,omitempty" doc:"If invitation confirmation is required when add account to project"`
+	RegionSecondaryEnabled    bool   `json:"regionsecondaryenabled,omitempty" doc:"true if region wide secondary is enabled, false otherwise"`
+	SecurityGroupsEnabled     bool   `json:"securitygroupsenabled,omitempty" doc:"true if security groups support is enabled, false otherwise"`
+	SupportELB                string `json:"supportELB,omitempty" doc:"true if region supports elastic load balancer on basic zones"`
+	UserPublicTemplateEnabled bool   `json:"userpublictemplateenabled,omitempty" doc:"true if user and domain admins can set templates to be shared, false otherwise"`
+}
+
+// Capabilities represents the capability and the configurations of the cloud, see Client.Capabilities
+type Capabilities struct {
+	Capability
+	Configurations []Configuration
+}
+
+// ListCapabilities represents a query to list the capabilities of the cloud
+//
+// CloudStack API: https://cloudstack.apache.org/api/apidocs-4.10/apis/listCapabilities.html
+type ListCapabilities struct{}
@@ -0,0 +1,138 @@
+package egoscale
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// HostCapacityReport sums up the allocation of the hosts per zone or per cluster
+//
+// The reports are sorted by zone and cluster names.
+func HostCapacityReport(hosts []Host, byCluster bool) []HostCapacity {
+	type group struct {
+		zoneID    string
+		clusterID string
+	}
+
+	groups := make(map[group]*HostCapacity)
+	for _, host := range hosts {
+		g := group{zoneID: host.ZoneID}
+		if byCluster {
+			g.clusterID = host.ClusterID
+		}
+
+		capacity, ok := groups[g]
+		if !ok {
+			capacity = &HostCapacity{
+				ZoneID:   host.ZoneID,
+				ZoneName: host.ZoneName,
+			}
+			if byCluster {
+				capacity.ClusterID = host.ClusterID
+				capacity.ClusterName = host.ClusterName
+			}
+			groups[g] = capacity
+		}
+
+		capacity.Hosts++
+		capacity.CPUAllocated += host.CPUAllocated
+		capacity.CPUTotal += host.CPUWithOverProvisioning
+		capacity.MemoryAllocated += host.MemoryAllocated
+		capacity.MemoryTotal += host.MemoryTotal
+		capacity.DiskAllocated += host.DiskSizeAllocated
+		capacity.DiskTotal += host.DiskSizeTotal
+	}
+
+	capacities := make([]HostCapacity, 0, len(groups))
+	for _, capacity := range groups {
+		capacities = append(capacities, *capacity)
+	}
+
+	sort.Slice(capacities, func(i, j int) bool {
+		if capacities[i].ZoneName != capacities[j].ZoneName {
+			return capacities[i].ZoneName < capacities[j].ZoneName
+		}
+		return capacities[i].ClusterName < capacities[j].ClusterName
+	})
+
+	return capacities
+}
+
+// CapacityReport sums up the allocation of the hypervisors per zone or per cluster, see HostCapacityReport
+func (client *Client) CapacityReport(ctx context.Context, byCluster bool) ([]HostCapacity, error) {
+	hosts := make([]Host, 0)
+
+	var err error
+	client.PaginateWithContext(ctx, &ListHosts{Type: "Routing"}, func(item interface{}, e error) bool {
+		if e != nil {
+			err = e
+			return false
+		}
+		hosts = append(hosts, *item.(*Host))
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return HostCapacityReport(hosts, byCluster), nil
+}
+
+// CPUPercent returns the percentage of allocated CPU
+func (capacity HostCapacity) CPUPercent() float64 {
+	return percent(capacity.CPUAllocated, capacity.CPUTotal)
+}
+
+// MemoryPercent returns the percentage of allocated memory
+func (capacity HostCapacity) MemoryPercent() float64 {
+	return percent(capacity.MemoryAllocated, capacity.MemoryTotal)
+}
+
+// DiskPercent returns the percentage of allocated disk
+func (capacity HostCapacity) DiskPercent() float64 {
+	return percent(capacity.DiskAllocated, capacity.DiskTotal)
+}
+
+func percent(allocated, total int64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return 100 * float64(allocated) / float64(total)
+}
+
+func (*ListCapacity) name() string {
+	return "listCapacity"
+}
+
+func (*ListCapacity) description() string {
+	return "Lists all the system wide capacities."
+}
+
+func (*ListCapacity) response() interface{} {
+	return new(ListCapacityResponse)
+}
+
+// SetPage sets the current page
+func (ls *ListCapacity) SetPage(page int) {
+	ls.Page = page
+}
+
+// SetPageSize sets the page size
+func (ls *ListCapacity) SetPageSize(pageSize int) {
+	ls.PageSize = pageSize
+}
+
+func (*ListCapacity) each(resp interface{}, callback IterateItemFunc) {
+	capacities, ok := resp.(*ListCapacityResponse)
+	if !ok {
+		callback(nil, fmt.Errorf("wrong type. ListCapacityResponse expected, got %T", resp))
+		return
+	}
+
+	for i := range capacities.Capacity {
+		if !callback(&capacities.Capacity[i], nil) {
+			break
+		}
+	}
+}
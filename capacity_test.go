@@ -0,0 +1,122 @@
+package egoscale
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestListCapacity(t *testing.T) {
+	req := &ListCapacity{}
+	if req.name() != "listCapacity" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.response().(*ListCapacityResponse)
+}
+
+func TestListCapacityMemoryType(t *testing.T) {
+	cs := NewClient("https://example.com/", "KEY", "SECRET")
+
+	memory := MemoryCapacity
+	payload, err := cs.Payload(&ListCapacity{Type: &memory})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(payload, "type=0") {
+		t.Errorf("the memory type was expected, got %q", payload)
+	}
+}
+
+func TestHostCapacityReport(t *testing.T) {
+	hosts := []Host{
+		{ZoneID: "z1", ZoneName: "ch-gva-2", ClusterID: "c2", ClusterName: "b", CPUAllocated: 10, CPUWithOverProvisioning: 40, MemoryAllocated: 4, MemoryTotal: 16, DiskSizeAllocated: 0, DiskSizeTotal: 100},
+		{ZoneID: "z1", ZoneName: "ch-gva-2", ClusterID: "c1", ClusterName: "a", CPUAllocated: 30, CPUWithOverProvisioning: 40, MemoryAllocated: 12, MemoryTotal: 16, DiskSizeAllocated: 50, DiskSizeTotal: 100},
+		{ZoneID: "z2", ZoneName: "ch-dk-2", ClusterID: "c3", ClusterName: "a", CPUAllocated: 5, CPUWithOverProvisioning: 10},
+	}
+
+	zones := HostCapacityReport(hosts, false)
+	if len(zones) != 2 {
+		t.Fatalf("two zones were expected, got %d", len(zones))
+	}
+
+	if zones[0].ZoneName != "ch-dk-2" || zones[0].ClusterID != "" {
+		t.Errorf("bad order, got %#v", zones[0])
+	}
+
+	gva := zones[1]
+	if gva.Hosts != 2 || gva.CPUPercent() != 50 || gva.MemoryPercent() != 50 || gva.DiskPercent() != 25 {
+		t.Errorf("bad zone capacity, got %#v", gva)
+	}
+
+	clusters := HostCapacityReport(hosts, true)
+	if len(clusters) != 3 {
+		t.Fatalf("three clusters were expected, got %d", len(clusters))
+	}
+
+	if clusters[1].ClusterName != "a" || clusters[1].CPUPercent() != 75 {
+		t.Errorf("bad cluster capacity, got %#v", clusters[1])
+	}
+
+	if clusters[0].DiskPercent() != 0 {
+		t.Errorf("no disk capacity was expected, got %f", clusters[0].DiskPercent())
+	}
+}
+
+func TestClientCapacityReport(t *testing.T) {
+	ts := newServer(response{200, jsonContentType, `
+{"listhostsresponse": {
+	"count": 2,
+	"host": [
+		{
+			"clusterid": "5fd2f4b5-4d53-4d5f-8b44-7e5b3d5b5f9a",
+			"clustername": "cluster1",
+			"cpuallocated": 8000,
+			"cpunumber": 8,
+			"cpuspeed": 2000,
+			"cpuwithoverprovisioning": 16000,
+			"disksizeallocated": 0,
+			"disksizetotal": 0,
+			"id": "2c1d8c9e-3b0a-4d6e-9a9b-3d3c2b1a0f9e",
+			"memoryallocated": 8589934592,
+			"memorytotal": 34359738368,
+			"name": "host1",
+			"type": "Routing",
+			"zoneid": "1128bd56-b4d9-4ac6-a7b9-c715b187ce11",
+			"zonename": "ch-gva-2"
+		},
+		{
+			"clusterid": "5fd2f4b5-4d53-4d5f-8b44-7e5b3d5b5f9a",
+			"clustername": "cluster1",
+			"cpuallocated": 0,
+			"cpunumber": 8,
+			"cpuspeed": 2000,
+			"cpuwithoverprovisioning": 16000,
+			"id": "7e9b0a3c-8d2f-4b1e-a6c5-0f4d3e2b1a9c",
+			"memoryallocated": 8589934592,
+			"memorytotal": 34359738368,
+			"name": "host2",
+			"type": "Routing",
+			"zoneid": "1128bd56-b4d9-4ac6-a7b9-c715b187ce11",
+			"zonename": "ch-gva-2"
+		}
+	]
+}}`})
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+
+	capacities, err := cs.CapacityReport(context.TODO(), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(capacities) != 1 {
+		t.Fatalf("one cluster was expected, got %d", len(capacities))
+	}
+
+	capacity := capacities[0]
+	if capacity.ClusterName != "cluster1" || capacity.Hosts != 2 || capacity.CPUPercent() != 25 || capacity.MemoryPercent() != 25 {
+		t.Errorf("bad capacity, got %#v", capacity)
+	}
+}
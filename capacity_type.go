@@ -0,0 +1,79 @@
+package egoscale
+
+// CapacityType represents the kind of capacity
+type CapacityType int
+
+const (
+	// MemoryCapacity represents the memory capacity
+	MemoryCapacity CapacityType = 0
+	// CPUCapacity represents the CPU capacity
+	CPUCapacity CapacityType = 1
+	// StorageCapacity represents the primary storage capacity
+	StorageCapacity CapacityType = 2
+	// StorageAllocatedCapacity represents the allocated primary storage capacity
+	StorageAllocatedCapacity CapacityType = 3
+	// PublicIPCapacity represents the virtual network public IP addresses
+	PublicIPCapacity CapacityType = 4
+	// PrivateIPCapacity represents the private IP addresses
+	PrivateIPCapacity CapacityType = 5
+	// SecondaryStorageCapacity represents the secondary storage capacity
+	SecondaryStorageCapacity CapacityType = 6
+	// VLANCapacity represents the VLANs
+	VLANCapacity CapacityType = 7
+	// DirectAttachedPublicIPCapacity represents the direct attached public IP addresses
+	DirectAttachedPublicIPCapacity CapacityType = 8
+	// LocalStorageCapacity represents the local storage capacity
+	LocalStorageCapacity CapacityType = 9
+	// GPUCapacity represents the GPU capacity
+	GPUCapacity CapacityType = 19
+)
+
+// Capacity represents the usage of a kind of resource in a zone, pod or cluster
+type Capacity struct {
+	CapacityTotal int64        `json:"capacitytotal,omitempty" doc:"the total capacity available"`
+	CapacityUsed  int64        `json:"capacityused,omitempty" doc:"the capacity currently in use"`
+	ClusterID     string       `json:"clusterid,omitempty" doc:"the Cluster ID"`
+	ClusterName   string       `json:"clustername,omitempty" doc:"the Cluster name"`
+	PercentUsed   string       `json:"percentused,omitempty" doc:"the percentage of capacity currently in use"`
+	PodID         string       `json:"podid,omitempty" doc:"the Pod ID"`
+	PodName       string       `json:"podname,omitempty" doc:"the Pod name"`
+	Type          CapacityType `json:"type,omitempty" doc:"the capacity type"`
+	ZoneID        string       `json:"zoneid,omitempty" doc:"the Zone ID"`
+	ZoneName      string       `json:"zonename,omitempty" doc:"the Zone name"`
+}
+
+// HostCapacity represents the allocation of the hosts of a zone or a cluster, see HostCapacityReport
+type HostCapacity struct {
+	ZoneID          string
+	ZoneName        string
+	ClusterID       string
+	ClusterName     string
+	Hosts           int
+	CPUAllocated    int64
+	CPUTotal        int64
+	MemoryAllocated int64
+	MemoryTotal     int64
+	DiskAllocated   int64
+	DiskTotal       int64
+}
+
+// ListCapacity represents a query to list the system wide capacity
+//
+// CloudStack API: https://cloudstack.apache.org/api/apidocs-4.10/apis/listCapacity.html
+type ListCapacity struct {
+	ClusterID   string        `json:"clusterid,omitempty" doc:"lists capacity by the Cluster ID"`
+	FetchLatest *bool         `json:"fetchlatest,omitempty" doc:"recalculate capacities and fetch the latest"`
+	Keyword     string        `json:"keyword,omitempty" doc:"List by keyword"`
+	Page        int           `json:"page,omitempty"`
+	PageSize    int           `json:"pagesize,omitempty"`
+	PodID       string        `json:"podid,omitempty" doc:"lists capacity by the Pod ID"`
+	SortBy      string        `json:"sortby,omitempty" doc:"Sort the results. Available values: Usage"`
+	Type        *CapacityType `json:"type,omitempty" doc:"lists capacity by type"`
+	ZoneID      string        `json:"zoneid,omitempty" doc:"lists capacity by the Zone ID"`
+}
+
+// ListCapacityResponse represents a list of capacities
+type ListCapacityResponse struct {
+	Count    int        `json:"count"`
+	Capacity []Capacity `json:"capacity"`
+}
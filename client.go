@@ -5,10 +5,9 @@ import (
 	"crypto/tls"
 	"fmt"
 	"net/http"
+	"reflect"
 	"strings"
 	"time"
-
-	"github.com/jinzhu/copier"
 )
 
 // Get populates the given resource or fails
@@ -32,25 +31,67 @@ func (client *Client) GetWithContext(ctx context.Context, g Gettable) error {
 		if err != nil {
 			return err
 		}
-		payload, err := client.Payload(req)
-		if err != nil {
-			return err
-		}
-
-		// formatting the query string nicely
-		payload = strings.Replace(payload, "&", ", ", -1)
+		query := listQuery(req)
 
 		if count == 0 {
 			return &ErrorResponse{
 				ErrorCode: ParamError,
-				ErrorText: fmt.Sprintf("not found, query: %s", payload),
+				ErrorText: fmt.Sprintf("not found, query: %s", query),
 			}
 		}
-		return fmt.Errorf("more than one element found: %s", payload)
+		return fmt.Errorf("more than one element found: %s", query)
+	}
+
+	return populate(g, gs[0])
+}
+
+// populate copies the found list item into the resource, both being of the same type
+func populate(g Gettable, item interface{}) error {
+	dst := reflect.ValueOf(g)
+	src := reflect.ValueOf(item)
+	if !src.IsValid() || src.Type() != dst.Type() || dst.Kind() != reflect.Ptr || dst.IsNil() || src.IsNil() {
+		return fmt.Errorf("wrong type. %T expected, got %T", g, item)
+	}
+
+	dst.Elem().Set(src.Elem())
+	return nil
+}
+
+// listQuery formats the non-empty fields of the list command
+//
+// Unlike Payload, it doesn't go through the Validator.
+func listQuery(req ListCommand) string {
+	fields := []string{fmt.Sprintf("command=%s", req.name())}
+
+	val := reflect.ValueOf(req)
+	for val.Kind() == reflect.Ptr && !val.IsNil() {
+		val = val.Elem()
+	}
+	if val.Kind() != reflect.Struct {
+		return fields[0]
 	}
 
-	return copier.Copy(g, gs[0])
+	typeof := val.Type()
+	for i := 0; i < typeof.NumField(); i++ {
+		field := typeof.Field(i)
+		json, ok := field.Tag.Lookup("json")
+		if !ok || field.PkgPath != "" {
+			continue
+		}
+
+		value := val.Field(i)
+		if isEmptyParam(value) {
+			continue
+		}
+		for value.Kind() == reflect.Ptr {
+			value = value.Elem()
+		}
+
+		name, _ := ExtractJSONTag(field.Name, json)
+		fields = append(fields, fmt.Sprintf("%s=%v", name, value.Interface()))
+	}
 
+	return strings.Join(fields, ", ")
 }
 
 // Delete removes the given resource of fails
@@ -162,7 +203,7 @@ func (client *Client) Paginate(req ListCommand, callback IterateItemFunc) {
 
 // PaginateWithContext runs the ListCommand as long as the ctx is valid
 func (client *Client) PaginateWithContext(ctx context.Context, req ListCommand, callback IterateItemFunc) {
-	pageSize := client.PageSize
+	pageSize := client.pageSize()
 
 	page := 1
 
@@ -201,6 +242,21 @@ func (client *Client) PaginateWithContext(ctx context.Context, req ListCommand,
 	}
 }
 
+// pageSize returns the page size, within the limit of the cloud once the capabilities are fetched
+func (client *Client) pageSize() int {
+	client.capabilitiesLock.Lock()
+	capabilities := client.capabilities
+	client.capabilitiesLock.Unlock()
+
+	if capabilities != nil {
+		if max := capabilities.MaxPageSize(); max > 0 && client.PageSize > max {
+			return max
+		}
+	}
+
+	return client.PageSize
+}
+
 // APIName returns the CloudStack name of the given command
 func (client *Client) APIName(request Command) string {
 	return request.name()
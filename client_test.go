@@ -61,6 +61,9 @@ func TestClientSyncDelete(t *testing.T) {
 		{"securitygroup", &SecurityGroup{ID: "test"}},
 		{"securitygroup", &SecurityGroup{Name: "test"}},
 		{"sshkeypair", &SSHKeyPair{Name: "test"}},
+		{"volume", &Volume{ID: "test"}},
+		{"user", &User{ID: "test"}},
+		{"instancegroup", &InstanceGroup{ID: "test"}},
 	}
 
 	for _, thing := range things {
@@ -112,6 +115,15 @@ func TestClientAsyncDelete(t *testing.T) {
 		{"deleteaffinitygroup", &AffinityGroup{Name: "affinity group name"}},
 		{"disassociateipaddress", &IPAddress{ID: "ip address id"}},
 		{"destroyvirtualmachine", &VirtualMachine{ID: "virtual machine id"}},
+		{"deletevmsnapshot", &VMSnapshot{ID: "vm snapshot id"}},
+		{"deleteiso", &ISO{ID: "iso id"}},
+		{"deleteportforwardingrule", &PortForwardingRule{ID: "port forwarding rule id"}},
+		{"deleteloadbalancerrule", &LoadBalancerRule{ID: "load balancer rule id"}},
+		{"deleteaccount", &Account{ID: "account id"}},
+		{"deletedomain", &Domain{ID: "domain id"}},
+		{"deletesnapshot", &Snapshot{ID: "snapshot id"}},
+		{"deletetemplate", &Template{ID: "template id"}},
+		{"deletenetwork", &Network{ID: "network id"}},
 	}
 
 	for _, thing := range things {
@@ -139,6 +151,13 @@ func TestClientDeleteFailure(t *testing.T) {
 		&SSHKeyPair{},
 		&VirtualMachine{},
 		&IPAddress{},
+		&Volume{},
+		&Snapshot{},
+		&Template{},
+		&Network{},
+		&Nic{ID: "nic id"},
+		&InstanceGroup{},
+		&User{},
 	}
 
 	for _, thing := range things {
@@ -201,6 +220,10 @@ func TestClientGetNone(t *testing.T) {
 		{"volumes", &Volume{ID: "1"}},
 		{"templates", &Template{ID: "1", IsFeatured: true}},
 		{"serviceofferings", &ServiceOffering{ID: "1"}},
+		{"templates", &Template{ID: "1"}},
+		{"snapshots", &Snapshot{ID: "1"}},
+		{"instancegroups", &InstanceGroup{ID: "1"}},
+		{"users", &User{ID: "1"}},
 	}
 
 	for _, thing := range things {
@@ -287,6 +310,101 @@ func TestClientGetTooMany(t *testing.T) {
 	}
 }
 
+func TestClientGet(t *testing.T) {
+	ts := newServer(response{200, jsonContentType, `
+{"listsecuritygroupsresponse": {
+	"count": 1,
+	"securitygroup": [{
+		"id": "4bfe1073-a6d4-48bd-8f24-2ab586674092",
+		"name": "default",
+		"description": "Default Security Group",
+		"ingressrule": [{"ruleid": "1", "protocol": "tcp", "startport": 22, "endport": 22}]
+	}]
+}}`})
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+
+	sg := &SecurityGroup{Name: "default"}
+	if err := cs.Get(sg); err != nil {
+		t.Fatal(err)
+	}
+
+	if sg.ID != "4bfe1073-a6d4-48bd-8f24-2ab586674092" {
+		t.Errorf("bad ID, got %q", sg.ID)
+	}
+
+	if len(sg.IngressRule) != 1 {
+		t.Errorf("one ingress rule was expected, got %d", len(sg.IngressRule))
+	}
+}
+
+func TestGettablePopulateWrongType(t *testing.T) {
+	things := []Gettable{
+		&AffinityGroup{},
+		&IPAddress{},
+		&Network{},
+		&Nic{},
+		&SecurityGroup{},
+		&ServiceOffering{},
+		&SSHKeyPair{},
+		&Template{},
+		&VirtualMachine{},
+		&Volume{},
+		&Zone{},
+	}
+
+	for _, thing := range things {
+		if err := populate(thing, &DNSRecord{}); err == nil {
+			t.Errorf("populate of %T with a DNSRecord should have failed", thing)
+		}
+		if err := populate(thing, nil); err == nil {
+			t.Errorf("populate of %T with nil should have failed", thing)
+		}
+	}
+
+	zone := &Zone{Name: "foo"}
+	if err := populate(zone, &Zone{ID: "1", Name: "bar"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if zone.ID != "1" || zone.Name != "bar" {
+		t.Errorf("zone doesn't match, got %#v", zone)
+	}
+}
+
+func TestGetNotFoundWithValidator(t *testing.T) {
+	ts := newServer(response{200, jsonContentType, `{"listzonesresponse": {}}`})
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+	zone := &Zone{Name: "ch-gva-2"}
+	if err := cs.Get(zone); err == nil {
+		t.Fatal("an error was expected")
+	}
+
+	// the not found message is built from the fields, not from the Payload
+	ts = newServer(response{200, jsonContentType, `{"listtemplatesresponse": {}}`})
+	defer ts.Close()
+
+	cs = NewClient(ts.URL, "KEY", "SECRET")
+	cs.Validator = NewValidator(API{
+		Name:   "listTemplates",
+		Params: []APIParam{{Name: "name", Type: "string", Length: 255}},
+	})
+	err := cs.Get(&Template{Name: "Linux Debian 9", ZoneID: "1128bd56-b4d9-4ac6-a7b9-c715b187ce11"})
+
+	e, ok := err.(*ErrorResponse)
+	if !ok {
+		t.Fatalf("ErrorResponse expected, got %#v", err)
+	}
+
+	expected := "not found, query: command=listTemplates, templatefilter=executable, name=Linux Debian 9, zoneid=1128bd56-b4d9-4ac6-a7b9-c715b187ce11"
+	if e.ErrorText != expected {
+		t.Errorf("message doesn't match, got %q", e.ErrorText)
+	}
+}
+
 func TestBooleanResponse(t *testing.T) {
 	body := `{"success": true, "displaytext": "yay!"}`
 	response := new(booleanResponse)
@@ -354,3 +472,62 @@ func TestBooleanResponseInvalid(t *testing.T) {
 		t.Errorf("An error was expected")
 	}
 }
+
+func TestResourceInterfaces(t *testing.T) {
+	var _ Gettable = (*Account)(nil)
+	var _ Gettable = (*AffinityGroup)(nil)
+	var _ Gettable = (*DiskOffering)(nil)
+	var _ Gettable = (*Domain)(nil)
+	var _ Gettable = (*InstanceGroup)(nil)
+	var _ Gettable = (*IPAddress)(nil)
+	var _ Gettable = (*ISO)(nil)
+	var _ Gettable = (*LoadBalancerRule)(nil)
+	var _ Gettable = (*Network)(nil)
+	var _ Gettable = (*Nic)(nil)
+	var _ Gettable = (*OSCategory)(nil)
+	var _ Gettable = (*OSType)(nil)
+	var _ Gettable = (*PortForwardingRule)(nil)
+	var _ Gettable = (*SecurityGroup)(nil)
+	var _ Gettable = (*ServiceOffering)(nil)
+	var _ Gettable = (*Snapshot)(nil)
+	var _ Gettable = (*SSHKeyPair)(nil)
+	var _ Gettable = (*Template)(nil)
+	var _ Gettable = (*User)(nil)
+	var _ Gettable = (*VirtualMachine)(nil)
+	var _ Gettable = (*VMSnapshot)(nil)
+	var _ Gettable = (*Volume)(nil)
+	var _ Gettable = (*Zone)(nil)
+
+	var _ Deletable = (*Account)(nil)
+	var _ Deletable = (*AffinityGroup)(nil)
+	var _ Deletable = (*Domain)(nil)
+	var _ Deletable = (*InstanceGroup)(nil)
+	var _ Deletable = (*IPAddress)(nil)
+	var _ Deletable = (*ISO)(nil)
+	var _ Deletable = (*LoadBalancerRule)(nil)
+	var _ Deletable = (*Network)(nil)
+	var _ Deletable = (*Nic)(nil)
+	var _ Deletable = (*PortForwardingRule)(nil)
+	var _ Deletable = (*SecurityGroup)(nil)
+	var _ Deletable = (*Snapshot)(nil)
+	var _ Deletable = (*SSHKeyPair)(nil)
+	var _ Deletable = (*Template)(nil)
+	var _ Deletable = (*User)(nil)
+	var _ Deletable = (*VirtualMachine)(nil)
+	var _ Deletable = (*VMSnapshot)(nil)
+	var _ Deletable = (*Volume)(nil)
+
+	var _ Taggable = (*IPAddress)(nil)
+	var _ Taggable = (*ISO)(nil)
+	var _ Taggable = (*LoadBalancerRule)(nil)
+	var _ Taggable = (*Network)(nil)
+	var _ Taggable = (*PortForwardingRule)(nil)
+	var _ Taggable = (*SecurityGroup)(nil)
+	var _ Taggable = (*Snapshot)(nil)
+	var _ Taggable = (*Template)(nil)
+	var _ Taggable = (*User)(nil)
+	var _ Taggable = (*VirtualMachine)(nil)
+	var _ Taggable = (*VMSnapshot)(nil)
+	var _ Taggable = (*Volume)(nil)
+	var _ Taggable = (*Zone)(nil)
+}
@@ -3,15 +3,18 @@ package egoscale
 import (
 	"context"
 	"net/http"
+	"sync"
 	"time"
 )
 
 // Taggable represents a resource which can have tags attached
 //
-// This is a helper to fill the resourcetype of a CreateTags call
+// This is a helper to fill the resourcetype and resourceids of a CreateTags call
 type Taggable interface {
 	// CloudStack resource type of the Taggable type
 	ResourceType() string
+	// ResourceID is the identifier of the Taggable resource
+	ResourceID() string
 }
 
 // Deletable represents an Interface that can be "Delete" by the client
@@ -47,6 +50,12 @@ type Client struct {
 	Timeout time.Duration
 	// RetryStrategy represents the waiting strategy for polling the async requests
 	RetryStrategy RetryStrategyFunc
+	// Validator, when set, checks the commands before sending them
+	Validator *Validator
+
+	// capabilities caches the result of Capabilities
+	capabilities     *Capabilities
+	capabilitiesLock sync.Mutex
 }
 
 // RetryStrategyFunc represents a how much time to wait between two calls to CloudStack
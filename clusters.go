@@ -0,0 +1,39 @@
+package egoscale
+
+import "fmt"
+
+func (*ListClusters) name() string {
+	return "listClusters"
+}
+
+func (*ListClusters) description() string {
+	return "Lists clusters."
+}
+
+func (*ListClusters) response() interface{} {
+	return new(ListClustersResponse)
+}
+
+// SetPage sets the current page
+func (ls *ListClusters) SetPage(page int) {
+	ls.Page = page
+}
+
+// SetPageSize sets the page size
+func (ls *ListClusters) SetPageSize(pageSize int) {
+	ls.PageSize = pageSize
+}
+
+func (*ListClusters) each(resp interface{}, callback IterateItemFunc) {
+	clusters, ok := resp.(*ListClustersResponse)
+	if !ok {
+		callback(nil, fmt.Errorf("wrong type. ListClustersResponse expected, got %T", resp))
+		return
+	}
+
+	for i := range clusters.Cluster {
+		if !callback(&clusters.Cluster[i], nil) {
+			break
+		}
+	}
+}
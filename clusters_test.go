@@ -0,0 +1,13 @@
+package egoscale
+
+import (
+	"testing"
+)
+
+func TestListClusters(t *testing.T) {
+	req := &ListClusters{}
+	if req.name() != "listClusters" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.response().(*ListClustersResponse)
+}
@@ -0,0 +1,42 @@
+package egoscale
+
+// Cluster represents a group of hosts sharing the same primary storage
+type Cluster struct {
+	AllocationState       string     `json:"allocationstate,omitempty" doc:"the allocation state of the cluster"`
+	Capacity              []Capacity `json:"capacity,omitempty" doc:"the capacity of the Cluster"`
+	ClusterType           string     `json:"clustertype,omitempty" doc:"the type of the cluster"`
+	CPUOvercommitRatio    string     `json:"cpuovercommitratio,omitempty" doc:"The cpu overcommit ratio of the cluster"`
+	HypervisorType        string     `json:"hypervisortype,omitempty" doc:"the hypervisor type of the cluster"`
+	ID                    string     `json:"id,omitempty" doc:"the cluster ID"`
+	ManagedState          string     `json:"managedstate,omitempty" doc:"whether this cluster is managed by cloudstack"`
+	MemoryOvercommitRatio string     `json:"memoryovercommitratio,omitempty" doc:"The memory overcommit ratio of the cluster"`
+	Name                  string     `json:"name,omitempty" doc:"the cluster name"`
+	PodID                 string     `json:"podid,omitempty" doc:"the Pod ID of the cluster"`
+	PodName               string     `json:"podname,omitempty" doc:"the Pod name of the cluster"`
+	ZoneID                string     `json:"zoneid,omitempty" doc:"the Zone ID of the cluster"`
+	ZoneName              string     `json:"zonename,omitempty" doc:"the Zone name of the cluster"`
+}
+
+// ListClusters represents a query to list the clusters
+//
+// CloudStack API: https://cloudstack.apache.org/api/apidocs-4.10/apis/listClusters.html
+type ListClusters struct {
+	AllocationState string `json:"allocationstate,omitempty" doc:"lists clusters by allocation state"`
+	ClusterType     string `json:"clustertype,omitempty" doc:"lists clusters by cluster type"`
+	HypervisorType  string `json:"hypervisor,omitempty" doc:"lists clusters by hypervisor type"`
+	ID              string `json:"id,omitempty" doc:"lists clusters by the cluster ID"`
+	Keyword         string `json:"keyword,omitempty" doc:"List by keyword"`
+	ManagedState    string `json:"managedstate,omitempty" doc:"whether this cluster is managed by cloudstack"`
+	Name            string `json:"name,omitempty" doc:"lists clusters by the cluster name"`
+	Page            int    `json:"page,omitempty"`
+	PageSize        int    `json:"pagesize,omitempty"`
+	PodID           string `json:"podid,omitempty" doc:"lists clusters by Pod ID"`
+	ShowCapacities  *bool  `json:"showcapacities,omitempty" doc:"flag to display the capacity of the clusters"`
+	ZoneID          string `json:"zoneid,omitempty" doc:"lists clusters by Zone ID"`
+}
+
+// ListClustersResponse represents a list of clusters
+type ListClustersResponse struct {
+	Count   int       `json:"count"`
+	Cluster []Cluster `json:"cluster"`
+}
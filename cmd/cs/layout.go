@@ -40,8 +40,14 @@ var methods = map[string][]cmd{
 		{&egoscale.UpdateVirtualMachine{}, false},
 	},
 	"volume": {
+		{&egoscale.AttachVolume{}, false},
+		{&egoscale.CreateVolume{}, false},
+		{&egoscale.DeleteVolume{}, false},
+		{&egoscale.DetachVolume{}, false},
+		{&egoscale.ExtractVolume{}, false},
 		{&egoscale.ListVolumes{}, false},
 		{&egoscale.ResizeVolume{}, false},
+		{&egoscale.UploadVolume{}, false},
 	},
 	"template": {
 		{&egoscale.CopyTemplate{}, true},
@@ -49,11 +55,36 @@ var methods = map[string][]cmd{
 		{&egoscale.ListTemplates{}, false},
 		{&egoscale.PrepareTemplate{}, true},
 		{&egoscale.RegisterTemplate{}, true},
+		{&egoscale.ListOsCategories{}, false},
+		{&egoscale.ListOsTypes{}, false},
+	},
+	"iso": {
+		{&egoscale.AttachIso{}, false},
+		{&egoscale.CopyIso{}, false},
+		{&egoscale.DeleteIso{}, false},
+		{&egoscale.DetachIso{}, false},
+		{&egoscale.ListIsos{}, false},
+		{&egoscale.RegisterIso{}, false},
 	},
 	"account": {
+		{&egoscale.CreateAccount{}, true},
+		{&egoscale.DeleteAccount{}, true},
 		{&egoscale.EnableAccount{}, true},
 		{&egoscale.DisableAccount{}, true},
 		{&egoscale.ListAccounts{}, false},
+		{&egoscale.LockAccount{}, true},
+		{&egoscale.UpdateAccount{}, true},
+	},
+	"domain": {
+		{&egoscale.CreateDomain{}, true},
+		{&egoscale.DeleteDomain{}, true},
+		{&egoscale.ListDomainChildren{}, true},
+		{&egoscale.ListDomains{}, true},
+		{&egoscale.UpdateDomain{}, true},
+	},
+	"usage": {
+		{&egoscale.ListUsageRecords{}, true},
+		{&egoscale.ListUsageTypes{}, true},
 	},
 	"zone": {
 		{&egoscale.ListZones{}, false},
@@ -63,6 +94,14 @@ var methods = map[string][]cmd{
 		{&egoscale.DeleteSnapshot{}, false},
 		{&egoscale.ListSnapshots{}, false},
 		{&egoscale.RevertSnapshot{}, false},
+		{&egoscale.CreateSnapshotPolicy{}, false},
+		{&egoscale.DeleteSnapshotPolicies{}, false},
+		{&egoscale.ListSnapshotPolicies{}, false},
+		{&egoscale.UpdateSnapshotPolicy{}, false},
+		{&egoscale.CreateVMSnapshot{}, false},
+		{&egoscale.DeleteVMSnapshot{}, false},
+		{&egoscale.ListVMSnapshot{}, false},
+		{&egoscale.RevertToVMSnapshot{}, false},
 	},
 	"user": {
 		{&egoscale.CreateUser{}, true},
@@ -115,23 +154,52 @@ var methods = map[string][]cmd{
 		{&egoscale.DisassociateIPAddress{}, false},
 		{&egoscale.ListPublicIPAddresses{}, false},
 		{&egoscale.UpdateIPAddress{}, false},
+		{&egoscale.EnableStaticNat{}, false},
+		{&egoscale.DisableStaticNat{}, false},
+		{&egoscale.CreatePortForwardingRule{}, false},
+		{&egoscale.DeletePortForwardingRule{}, false},
+		{&egoscale.ListPortForwardingRules{}, false},
+	},
+	"load balancer": {
+		{&egoscale.AssignToLoadBalancerRule{}, false},
+		{&egoscale.CreateLBHealthCheckPolicy{}, false},
+		{&egoscale.CreateLoadBalancerRule{}, false},
+		{&egoscale.DeleteLBHealthCheckPolicy{}, false},
+		{&egoscale.DeleteLoadBalancerRule{}, false},
+		{&egoscale.ListLBHealthCheckPolicies{}, false},
+		{&egoscale.ListLoadBalancerRuleInstances{}, false},
+		{&egoscale.ListLoadBalancerRules{}, false},
+		{&egoscale.RemoveFromLoadBalancerRule{}, false},
+		{&egoscale.UpdateLoadBalancerRule{}, false},
 	},
 	"async job": {
 		{&egoscale.QueryAsyncJobResult{}, false},
 	},
 	"apis": {
 		{&egoscale.ListAPIs{}, false},
+		{&egoscale.ListCapabilities{}, false},
+		{&egoscale.ListConfigurations{}, true},
 	},
 	"event": {
 		{&egoscale.ListEventTypes{}, false},
 		{&egoscale.ListEvents{}, false},
 	},
 	"offerings": {
+		{&egoscale.ListDiskOfferings{}, false},
+		{&egoscale.AddResourceDetail{}, false},
 		{&egoscale.ListResourceDetails{}, false},
+		{&egoscale.RemoveResourceDetail{}, false},
 		{&egoscale.ListResourceLimits{}, false},
 		{&egoscale.ListServiceOfferings{}, false},
 	},
 	"host": {
 		{&egoscale.ListHosts{}, true},
 	},
+	"infrastructure": {
+		{&egoscale.ListAlerts{}, true},
+		{&egoscale.ListCapacity{}, true},
+		{&egoscale.ListClusters{}, true},
+		{&egoscale.ListPods{}, true},
+		{&egoscale.ListStoragePools{}, true},
+	},
 }
@@ -0,0 +1,39 @@
+package egoscale
+
+import "fmt"
+
+func (*ListConfigurations) name() string {
+	return "listConfigurations"
+}
+
+func (*ListConfigurations) description() string {
+	return "Lists all configurations."
+}
+
+func (*ListConfigurations) response() interface{} {
+	return new(ListConfigurationsResponse)
+}
+
+// SetPage sets the current page
+func (ls *ListConfigurations) SetPage(page int) {
+	ls.Page = page
+}
+
+// SetPageSize sets the page size
+func (ls *ListConfigurations) SetPageSize(pageSize int) {
+	ls.PageSize = pageSize
+}
+
+func (*ListConfigurations) each(resp interface{}, callback IterateItemFunc) {
+	configurations, ok := resp.(*ListConfigurationsResponse)
+	if !ok {
+		callback(nil, fmt.Errorf("wrong type. ListConfigurationsResponse expected, got %T", resp))
+		return
+	}
+
+	for i := range configurations.Configuration {
+		if !callback(&configurations.Configuration[i], nil) {
+			break
+		}
+	}
+}
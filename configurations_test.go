@@ -0,0 +1,13 @@
+package egoscale
+
+import (
+	"testing"
+)
+
+func TestListConfigurations(t *testing.T) {
+	req := &ListConfigurations{}
+	if req.name() != "listConfigurations" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.response().(*ListConfigurationsResponse)
+}
@@ -0,0 +1,33 @@
+package egoscale
+
+// Configuration represents a global or scoped setting of CloudStack
+type Configuration struct {
+	Category    string `json:"category,omitempty" doc:"the category of the configuration"`
+	Description string `json:"description,omitempty" doc:"the description of the configuration"`
+	ID          string `json:"id,omitempty" doc:"the value of the configuration"`
+	IsDynamic   bool   `json:"isdynamic,omitempty" doc:"true if the configuration is dynamic"`
+	Name        string `json:"name,omitempty" doc:"the name of the configuration"`
+	Scope       string `json:"scope,omitempty" doc:"scope(zone/cluster/pool/account) of the parameter that needs to be updated"`
+	Value       string `json:"value,omitempty" doc:"the value of the configuration"`
+}
+
+// ListConfigurations represents a query to list the configurations
+//
+// CloudStack API: https://cloudstack.apache.org/api/apidocs-4.10/apis/listConfigurations.html
+type ListConfigurations struct {
+	AccountID string `json:"accountid,omitempty" doc:"the ID of the Account to update the parameter value for corresponding account"`
+	Category  string `json:"category,omitempty" doc:"lists configurations by category"`
+	ClusterID string `json:"clusterid,omitempty" doc:"the ID of the Cluster to update the parameter value for corresponding cluster"`
+	Keyword   string `json:"keyword,omitempty" doc:"List by keyword"`
+	Name      string `json:"name,omitempty" doc:"lists configuration by name"`
+	Page      int    `json:"page,omitempty"`
+	PageSize  int    `json:"pagesize,omitempty"`
+	StorageID string `json:"storageid,omitempty" doc:"the ID of the Storage pool to update the parameter value for corresponding storage pool"`
+	ZoneID    string `json:"zoneid,omitempty" doc:"the ID of the Zone to update the parameter value for corresponding zone"`
+}
+
+// ListConfigurationsResponse represents a list of configurations
+type ListConfigurationsResponse struct {
+	Count         int             `json:"count"`
+	Configuration []Configuration `json:"configuration"`
+}
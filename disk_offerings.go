@@ -0,0 +1,98 @@
+package egoscale
+
+import (
+	"context"
+	"fmt"
+)
+
+// ListRequest builds the ListDiskOfferings request
+func (do *DiskOffering) ListRequest() (ListCommand, error) {
+	req := &ListDiskOfferings{
+		DomainID: do.DomainID,
+		ID:       do.ID,
+		Name:     do.Name,
+	}
+
+	return req, nil
+}
+
+// DiskOfferingForSize finds the disk offering best fitting a disk of size GB
+//
+// A fixed size offering of exactly size GB comes first, then a customized one
+// and only then the smallest fixed size offering larger than size GB. When a
+// customized offering is returned the size has to be sent along its ID, e.g.
+// via DeployVirtualMachine.Size or CreateVolume.Size.
+func (client *Client) DiskOfferingForSize(ctx context.Context, size int64) (*DiskOffering, error) {
+	if size <= 0 {
+		return nil, fmt.Errorf("a disk size of at least 1 GB is required, got %d", size)
+	}
+
+	offerings, err := client.ListWithContext(ctx, &DiskOffering{})
+	if err != nil {
+		return nil, err
+	}
+
+	var fixed, custom *DiskOffering
+	for _, item := range offerings {
+		offering := item.(*DiskOffering)
+		switch {
+		case offering.IsCustomized:
+			if custom == nil {
+				custom = offering
+			}
+		case offering.DiskSize >= size:
+			if fixed == nil || offering.DiskSize < fixed.DiskSize {
+				fixed = offering
+			}
+		}
+	}
+
+	// a larger disk than asked for is the last resort
+	if fixed != nil && (fixed.DiskSize == size || custom == nil) {
+		return fixed, nil
+	}
+	if custom != nil {
+		return custom, nil
+	}
+
+	return nil, &ErrorResponse{
+		ErrorCode: ParamError,
+		ErrorText: fmt.Sprintf("not found, no disk offering of at least %d GB", size),
+	}
+}
+
+func (*ListDiskOfferings) name() string {
+	return "listDiskOfferings"
+}
+
+func (*ListDiskOfferings) description() string {
+	return "Lists all available disk offerings."
+}
+
+func (*ListDiskOfferings) response() interface{} {
+	return new(ListDiskOfferingsResponse)
+}
+
+// SetPage sets the current page
+func (ldo *ListDiskOfferings) SetPage(page int) {
+	ldo.Page = page
+}
+
+// SetPageSize sets the page size
+func (ldo *ListDiskOfferings) SetPageSize(pageSize int) {
+	ldo.PageSize = pageSize
+}
+
+func (*ListDiskOfferings) each(resp interface{}, callback IterateItemFunc) {
+	dos, ok := resp.(*ListDiskOfferingsResponse)
+	if !ok {
+		callback(nil, fmt.Errorf("wrong type. ListDiskOfferingsResponse expected, got %T", resp))
+		return
+	}
+
+	for i := range dos.DiskOffering {
+		if !callback(&dos.DiskOffering[i], nil) {
+			break
+		}
+	}
+}
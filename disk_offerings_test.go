@@ -0,0 +1,186 @@
+package egoscale
+
+import (
+	"context"
+	"testing"
+)
+
+const listDiskOfferingsResponse = `
+{"listdiskofferingsresponse": {
+	"count": 4,
+	"diskoffering": [
+		{
+			"created": "2013-01-25T14:21:15+0100",
+			"disksize": 100,
+			"displayoffering": true,
+			"displaytext": "Medium Disk, 100 GB",
+			"id": "8a4a5a36-29e2-4e33-9e5f-5e33b3b3c0a1",
+			"iscustomized": false,
+			"name": "Medium",
+			"provisioningtype": "thin",
+			"storagetype": "local"
+		},
+		{
+			"created": "2013-01-25T14:21:15+0100",
+			"disksize": 50,
+			"displayoffering": true,
+			"displaytext": "Small Disk, 50 GB",
+			"id": "1a6b4f0d-5c4d-4a0b-9f1c-3d3a5b5e6f70",
+			"iscustomized": false,
+			"name": "Small",
+			"provisioningtype": "thin",
+			"storagetype": "local"
+		},
+		{
+			"created": "2013-01-25T14:21:15+0100",
+			"disksize": 0,
+			"displayoffering": true,
+			"displaytext": "Custom Disk",
+			"id": "4fb6ab7e-2b0f-4c4b-8b5d-2d0f7b2c8f2d",
+			"iscustomized": true,
+			"iscustomizediops": true,
+			"name": "Custom",
+			"provisioningtype": "thin",
+			"storagetype": "local"
+		},
+		{
+			"created": "2013-01-25T14:21:15+0100",
+			"disksize": 20,
+			"displayoffering": true,
+			"displaytext": "Fast Disk, 20 GB",
+			"id": "c2a8b1f7-9b0d-4a38-a0e7-5a1f8c5f3e21",
+			"iscustomized": false,
+			"maxiops": 5000,
+			"miniops": 1000,
+			"name": "Fast",
+			"provisioningtype": "thin",
+			"storagetype": "local"
+		}
+	]
+}}`
+
+func TestListDiskOfferings(t *testing.T) {
+	req := &ListDiskOfferings{}
+	if req.name() != "listDiskOfferings" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.response().(*ListDiskOfferingsResponse)
+}
+
+func TestGetDiskOffering(t *testing.T) {
+	ts := newServer(response{200, jsonContentType, `
+{"listdiskofferingsresponse": {
+	"count": 1,
+	"diskoffering": [
+		{
+			"disksize": 20,
+			"id": "c2a8b1f7-9b0d-4a38-a0e7-5a1f8c5f3e21",
+			"maxiops": 5000,
+			"miniops": 1000,
+			"name": "Fast"
+		}
+	]
+}}`})
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+
+	do := &DiskOffering{Name: "Fast"}
+	if err := cs.Get(do); err != nil {
+		t.Fatal(err)
+	}
+
+	if do.MaxIops != 5000 {
+		t.Errorf("bad max IOPS, got %d", do.MaxIops)
+	}
+}
+
+func TestDiskOfferingForSize(t *testing.T) {
+	tests := []struct {
+		size int64
+		name string
+	}{
+		{10, "Custom"},
+		{20, "Fast"},
+		{21, "Custom"},
+		{50, "Small"},
+		{100, "Medium"},
+		{200, "Custom"},
+	}
+
+	for _, test := range tests {
+		ts := newServer(response{200, jsonContentType, listDiskOfferingsResponse})
+		defer ts.Close()
+
+		cs := NewClient(ts.URL, "KEY", "SECRET")
+
+		do, err := cs.DiskOfferingForSize(context.Background(), test.size)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+
+		if do.Name != test.name {
+			t.Errorf("%q disk offering was expected for %d GB, got %q", test.name, test.size, do.Name)
+		}
+	}
+}
+
+func TestDiskOfferingForSizeNotFound(t *testing.T) {
+	ts := newServer(response{200, jsonContentType, `
+{"listdiskofferingsresponse": {
+	"count": 1,
+	"diskoffering": [
+		{"disksize": 20, "id": "c2a8b1f7-9b0d-4a38-a0e7-5a1f8c5f3e21", "name": "Fast"}
+	]
+}}`})
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+
+	_, err := cs.DiskOfferingForSize(context.Background(), 50)
+	if err == nil {
+		t.Fatal("an error was expected")
+	}
+
+	if e, ok := err.(*ErrorResponse); !ok || e.ErrorCode != ParamError {
+		t.Errorf("a ParamError was expected, got %v", err)
+	}
+}
+
+func TestDiskOfferingForSizeFixed(t *testing.T) {
+	ts := newServer(response{200, jsonContentType, `
+{"listdiskofferingsresponse": {
+	"count": 2,
+	"diskoffering": [
+		{"disksize": 50, "id": "1a6b4f0d-5c4d-4a0b-9f1c-3d3a5b5e6f70", "name": "Small"},
+		{"disksize": 20, "id": "c2a8b1f7-9b0d-4a38-a0e7-5a1f8c5f3e21", "name": "Fast"}
+	]
+}}`})
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+
+	// without customized offering, the smallest larger one fits
+	do, err := cs.DiskOfferingForSize(context.Background(), 30)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if do.Name != "Small" {
+		t.Errorf("\"Small\" disk offering was expected, got %q", do.Name)
+	}
+}
+
+func TestDiskOfferingForSizeInvalid(t *testing.T) {
+	ts := newServer()
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+
+	for _, size := range []int64{0, -1} {
+		if _, err := cs.DiskOfferingForSize(context.Background(), size); err == nil {
+			t.Errorf("an error was expected for %d GB", size)
+		}
+	}
+}
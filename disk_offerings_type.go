@@ -0,0 +1,48 @@
+package egoscale
+
+// DiskOffering represents a kind of disk, with a fixed size or a custom one
+//
+// See: http://docs.cloudstack.apache.org/projects/cloudstack-administration/en/latest/service_offerings.html#compute-and-disk-service-offerings
+type DiskOffering struct {
+	CacheMode                 string `json:"cacheMode,omitempty" doc:"the cache mode to use for this disk offering. none, writeback or writethrough"`
+	Created                   string `json:"created,omitempty" doc:"the date this disk offering was created"`
+	DiskBytesReadRate         int64  `json:"diskBytesReadRate,omitempty" doc:"bytes read rate of the disk offering"`
+	DiskBytesWriteRate        int64  `json:"diskBytesWriteRate,omitempty" doc:"bytes write rate of the disk offering"`
+	DiskIopsReadRate          int64  `json:"diskIopsReadRate,omitempty" doc:"io requests read rate of the disk offering"`
+	DiskIopsWriteRate         int64  `json:"diskIopsWriteRate,omitempty" doc:"io requests write rate of the disk offering"`
+	DiskSize                  int64  `json:"disksize,omitempty" doc:"the size of the disk offering in GB"`
+	DisplayOffering           bool   `json:"displayoffering,omitempty" doc:"whether to display the offering to the end user or not."`
+	DisplayText               string `json:"displaytext,omitempty" doc:"an alternate display text of the disk offering."`
+	Domain                    string `json:"domain,omitempty" doc:"the domain name this disk offering belongs to. Ignore this information as it is not currently applicable."`
+	DomainID                  string `json:"domainid,omitempty" doc:"the domain ID this disk offering belongs to. Ignore this information as it is not currently applicable."`
+	HypervisorSnapshotReserve int    `json:"hypervisorsnapshotreserve,omitempty" doc:"Hypervisor snapshot reserve space as a percent of a volume (for managed storage using Xen or VMware)"`
+	ID                        string `json:"id,omitempty" doc:"unique ID of the disk offering"`
+	IsCustomized              bool   `json:"iscustomized,omitempty" doc:"true if disk offering uses custom size, false otherwise"`
+	IsCustomizedIops          bool   `json:"iscustomizediops,omitempty" doc:"true if disk offering uses custom iops, false otherwise"`
+	MaxIops                   int64  `json:"maxiops,omitempty" doc:"the max iops of the disk offering"`
+	MinIops                   int64  `json:"miniops,omitempty" doc:"the min iops of the disk offering"`
+	Name                      string `json:"name,omitempty" doc:"the name of the disk offering"`
+	ProvisioningType          string `json:"provisioningtype,omitempty" doc:"provisioning type used to create volumes. Valid values are thin, sparse, fat."`
+	StorageType               string `json:"storagetype,omitempty" doc:"the storage type for this disk offering"`
+	Tags                      string `json:"tags,omitempty" doc:"the tags for the disk offering"`
+}
+
+// ListDiskOfferings lists all available disk offerings
+//
+// CloudStack API: https://cloudstack.apache.org/api/apidocs-4.10/apis/listDiskOfferings.html
+type ListDiskOfferings struct {
+	DomainID    string `json:"domainid,omitempty" doc:"the ID of the domain of the disk offering."`
+	ID          string `json:"id,omitempty" doc:"ID of the disk offering"`
+	IsRecursive *bool  `json:"isrecursive,omitempty" doc:"defaults to false, but if true, lists all resources from the parent specified by the domainId till leaves."`
+	Keyword     string `json:"keyword,omitempty" doc:"List by keyword"`
+	ListAll     *bool  `json:"listall,omitempty" doc:"If set to false, list only resources belonging to the command's caller; if set to true - list resources that the caller is authorized to see. Default value is false"`
+	Name        string `json:"name,omitempty" doc:"name of the disk offering"`
+	Page        int    `json:"page,omitempty"`
+	PageSize    int    `json:"pagesize,omitempty"`
+}
+
+// ListDiskOfferingsResponse represents a list of disk offerings
+type ListDiskOfferingsResponse struct {
+	Count        int            `json:"count"`
+	DiskOffering []DiskOffering `json:"diskoffering"`
+}
@@ -44,7 +44,7 @@ type DNSRecord struct {
 	CreatedAt  string `json:"created_at,omitempty"`
 	UpdatedAt  string `json:"updated_at,omitempty"`
 	Content    string `json:"content"`
-	RecordType string `json:"record_type"`
+	RecordType string `json:"record_type" enum:"A,AAAA,ALIAS,CNAME,HINFO,MX,NAPTR,NS,POOL,SPF,SRV,SSHFP,TXT,URL"`
 	Prio       int    `json:"prio,omitempty"`
 }
 
@@ -159,6 +159,10 @@ func (client *Client) GetRecords(name string) ([]DNSRecord, error) {
 
 // CreateRecord creates a DNS record
 func (client *Client) CreateRecord(name string, rec DNSRecord) (*DNSRecord, error) {
+	if err := client.validateRecord(rec); err != nil {
+		return nil, err
+	}
+
 	body, err := json.Marshal(DNSRecordResponse{
 		Record: rec,
 	})
@@ -181,6 +185,10 @@ func (client *Client) CreateRecord(name string, rec DNSRecord) (*DNSRecord, erro
 
 // UpdateRecord updates a DNS record
 func (client *Client) UpdateRecord(name string, rec DNSRecord) (*DNSRecord, error) {
+	if err := client.validateRecord(rec); err != nil {
+		return nil, err
+	}
+
 	body, err := json.Marshal(DNSRecordResponse{
 		Record: rec,
 	})
@@ -210,6 +218,23 @@ func (client *Client) DeleteRecord(name string, recordID int64) error {
 	return err
 }
 
+// validateRecord checks the record type, if a Validator is set
+func (client *Client) validateRecord(rec DNSRecord) error {
+	if client.Validator == nil {
+		return nil
+	}
+
+	// an empty name is a valid record on the domain itself
+	violations := checkValues("", rec, false)
+	if len(violations) > 0 {
+		return &ValidationError{
+			Command:    "record",
+			Violations: violations,
+		}
+	}
+	return nil
+}
+
 func (client *Client) dnsRequest(uri string, params string, method string) (json.RawMessage, error) {
 	url := client.Endpoint + uri
 	req, err := http.NewRequest(method, url, strings.NewReader(params))
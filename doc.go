@@ -78,6 +78,24 @@ All the available APIs on the server and provided by the API Discovery plugin
 	// listNetworks Lists all available networks
 	// ...
 
+Validation
+
+The commands may be checked before being sent, all the violations (required fields, UUIDs, allowed values, mutually exclusive fields, length and types) are reported at once. The API descriptions are optional.
+
+	resp, err := cs.Request(&egoscale.ListAPIs{})
+	if err != nil {
+		panic(err)
+	}
+
+	cs.Validator = egoscale.NewValidator(resp.(*egoscale.ListAPIsResponse).API...)
+
+	_, err = cs.Request(&egoscale.DeployVirtualMachine{ZoneID: "ch-gva-2"})
+	if e, ok := err.(*egoscale.ValidationError); ok {
+		for _, violation := range e.Violations {
+			fmt.Println(violation)
+		}
+	}
+
 Security Groups
 
 Security Groups provide a way to isolate traffic to VMs. Rules are added via the two Authorization commands.
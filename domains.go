@@ -0,0 +1,136 @@
+package egoscale
+
+import (
+	"context"
+	"fmt"
+)
+
+// Delete removes the given domain, it has to be empty
+func (domain *Domain) Delete(ctx context.Context, client *Client) error {
+	if domain.ID == "" {
+		return fmt.Errorf("a Domain may only be deleted using ID")
+	}
+
+	return client.BooleanRequestWithContext(ctx, &DeleteDomain{
+		ID: domain.ID,
+	})
+}
+
+// ListRequest builds the ListDomains request
+func (domain *Domain) ListRequest() (ListCommand, error) {
+	req := &ListDomains{
+		ID:    domain.ID,
+		Level: domain.Level,
+		Name:  domain.Name,
+	}
+
+	return req, nil
+}
+
+func (*ListDomains) name() string {
+	return "listDomains"
+}
+
+func (*ListDomains) description() string {
+	return "Lists domains and provides detailed information for listed domains"
+}
+
+func (*ListDomains) response() interface{} {
+	return new(ListDomainsResponse)
+}
+
+// SetPage sets the current page
+func (ls *ListDomains) SetPage(page int) {
+	ls.Page = page
+}
+
+// SetPageSize sets the page size
+func (ls *ListDomains) SetPageSize(pageSize int) {
+	ls.PageSize = pageSize
+}
+
+func (*ListDomains) each(resp interface{}, callback IterateItemFunc) {
+	domains, ok := resp.(*ListDomainsResponse)
+	if !ok {
+		callback(nil, fmt.Errorf("wrong type. ListDomainsResponse expected, got %T", resp))
+		return
+	}
+
+	for i := range domains.Domain {
+		if !callback(&domains.Domain[i], nil) {
+			break
+		}
+	}
+}
+
+func (*ListDomainChildren) name() string {
+	return "listDomainChildren"
+}
+
+func (*ListDomainChildren) description() string {
+	return "Lists all children domains belonging to a specified domain"
+}
+
+func (*ListDomainChildren) response() interface{} {
+	return new(ListDomainChildrenResponse)
+}
+
+// SetPage sets the current page
+func (ls *ListDomainChildren) SetPage(page int) {
+	ls.Page = page
+}
+
+// SetPageSize sets the page size
+func (ls *ListDomainChildren) SetPageSize(pageSize int) {
+	ls.PageSize = pageSize
+}
+
+func (*ListDomainChildren) each(resp interface{}, callback IterateItemFunc) {
+	domains, ok := resp.(*ListDomainChildrenResponse)
+	if !ok {
+		callback(nil, fmt.Errorf("wrong type. ListDomainChildrenResponse expected, got %T", resp))
+		return
+	}
+
+	for i := range domains.Domain {
+		if !callback(&domains.Domain[i], nil) {
+			break
+		}
+	}
+}
+
+func (*CreateDomain) name() string {
+	return "createDomain"
+}
+
+func (*CreateDomain) description() string {
+	return "Creates a domain"
+}
+
+func (*CreateDomain) response() interface{} {
+	return new(Domain)
+}
+
+func (*UpdateDomain) name() string {
+	return "updateDomain"
+}
+
+func (*UpdateDomain) description() string {
+	return "Updates a domain with a new name"
+}
+
+func (*UpdateDomain) response() interface{} {
+	return new(Domain)
+}
+
+func (*DeleteDomain) name() string {
+	return "deleteDomain"
+}
+
+func (*DeleteDomain) description() string {
+	return "Deletes a specified domain"
+}
+
+func (*DeleteDomain) asyncResponse() interface{} {
+	return new(booleanResponse)
+}
@@ -0,0 +1,91 @@
+package egoscale
+
+import (
+	"testing"
+)
+
+func TestListDomains(t *testing.T) {
+	req := &ListDomains{}
+	if req.name() != "listDomains" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.response().(*ListDomainsResponse)
+}
+
+func TestListDomainChildren(t *testing.T) {
+	req := &ListDomainChildren{}
+	if req.name() != "listDomainChildren" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.response().(*ListDomainChildrenResponse)
+}
+
+func TestCreateDomain(t *testing.T) {
+	req := &CreateDomain{}
+	if req.name() != "createDomain" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.response().(*Domain)
+}
+
+func TestUpdateDomain(t *testing.T) {
+	req := &UpdateDomain{}
+	if req.name() != "updateDomain" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.response().(*Domain)
+}
+
+func TestDeleteDomain(t *testing.T) {
+	req := &DeleteDomain{}
+	if req.name() != "deleteDomain" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.asyncResponse().(*booleanResponse)
+}
+
+func TestListDomainChildrenPaginate(t *testing.T) {
+	ts := newServer(response{200, jsonContentType, `
+{"listdomainchildrenresponse": {
+	"count": 2,
+	"domain": [
+		{
+			"haschild": false,
+			"id": "6c1d2f3e-4a5b-4c6d-8e7f-9a0b1c2d3e4f",
+			"level": 1,
+			"name": "reseller",
+			"parentdomainid": "5e4f3a2b-1c0d-4e9f-8a7b-6c5d4e3f2a1b",
+			"parentdomainname": "ROOT",
+			"path": "ROOT/reseller",
+			"state": "Active"
+		},
+		{
+			"haschild": true,
+			"id": "7d2e3f4a-5b6c-4d7e-9f8a-0b1c2d3e4f5a",
+			"level": 1,
+			"name": "partners",
+			"parentdomainid": "5e4f3a2b-1c0d-4e9f-8a7b-6c5d4e3f2a1b",
+			"parentdomainname": "ROOT",
+			"path": "ROOT/partners",
+			"state": "Active"
+		}
+	]
+}}`})
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+
+	paths := make([]string, 0)
+	cs.Paginate(&ListDomainChildren{ID: "5e4f3a2b-1c0d-4e9f-8a7b-6c5d4e3f2a1b"}, func(item interface{}, err error) bool {
+		if err != nil {
+			t.Error(err)
+			return false
+		}
+		paths = append(paths, item.(*Domain).Path)
+		return true
+	})
+
+	if len(paths) != 2 || paths[1] != "ROOT/partners" {
+		t.Errorf("bad sub-domains, got %v", paths)
+	}
+}
@@ -0,0 +1,107 @@
+package egoscale
+
+// Domain represents a domain, a hierarchical grouping of accounts
+type Domain struct {
+	CPUAvailable              string `json:"cpuavailable,omitempty" doc:"the total number of cpu cores available to be created for this domain"`
+	CPULimit                  string `json:"cpulimit,omitempty" doc:"the total number of cpu cores the domain can own"`
+	CPUTotal                  int64  `json:"cputotal,omitempty" doc:"the total number of cpu cores owned by domain"`
+	HasChild                  bool   `json:"haschild,omitempty" doc:"whether the domain has one or more sub-domains"`
+	ID                        string `json:"id,omitempty" doc:"the ID of the domain"`
+	IPAvailable               string `json:"ipavailable,omitempty" doc:"the total number of public ip addresses available for this domain to acquire"`
+	IPLimit                   string `json:"iplimit,omitempty" doc:"the total number of public ip addresses this domain can acquire"`
+	IPTotal                   int64  `json:"iptotal,omitempty" doc:"the total number of public ip addresses allocated for this domain"`
+	Level                     int    `json:"level,omitempty" doc:"the level of the domain"`
+	MemoryAvailable           string `json:"memoryavailable,omitempty" doc:"the total memory (in MB) available to be created for this domain"`
+	MemoryLimit               string `json:"memorylimit,omitempty" doc:"the total memory (in MB) the domain can own"`
+	MemoryTotal               int64  `json:"memorytotal,omitempty" doc:"the total memory (in MB) owned by domain"`
+	Name                      string `json:"name,omitempty" doc:"the name of the domain"`
+	NetworkAvailable          string `json:"networkavailable,omitempty" doc:"the total number of networks available to be created for this domain"`
+	NetworkDomain             string `json:"networkdomain,omitempty" doc:"the network domain"`
+	NetworkLimit              string `json:"networklimit,omitempty" doc:"the total number of networks the domain can own"`
+	NetworkTotal              int64  `json:"networktotal,omitempty" doc:"the total number of networks owned by domain"`
+	ParentDomainID            string `json:"parentdomainid,omitempty" doc:"the domain ID of the parent domain"`
+	ParentDomainName          string `json:"parentdomainname,omitempty" doc:"the domain name of the parent domain"`
+	Path                      string `json:"path,omitempty" doc:"the path of the domain"`
+	PrimaryStorageAvailable   string `json:"primarystorageavailable,omitempty" doc:"the total primary storage space (in GiB) available to be used for this domain"`
+	PrimaryStorageLimit       string `json:"primarystoragelimit,omitempty" doc:"the total primary storage space (in GiB) the domain can own"`
+	PrimaryStorageTotal       int64  `json:"primarystoragetotal,omitempty" doc:"the total primary storage space (in GiB) owned by domain"`
+	SecondaryStorageAvailable string `json:"secondarystorageavailable,omitempty" doc:"the total secondary storage space (in GiB) available to be used for this domain"`
+	SecondaryStorageLimit     string `json:"secondarystoragelimit,omitempty" doc:"the total secondary storage space (in GiB) the domain can own"`
+	SecondaryStorageTotal     int64  `json:"secondarystoragetotal,omitempty" doc:"the total secondary storage space (in GiB) owned by domain"`
+	SnapshotAvailable         string `json:"snapshotavailable,omitempty" doc:"the total number of snapshots available for this domain"`
+	SnapshotLimit             string `json:"snapshotlimit,omitempty" doc:"the total number of snapshots which can be stored by this domain"`
+	SnapshotTotal             int64  `json:"snapshottotal,omitempty" doc:"the total number of snapshots stored by this domain"`
+	State                     string `json:"state,omitempty" doc:"the state of the domain"`
+	TemplateAvailable         string `json:"templateavailable,omitempty" doc:"the total number of templates available to be created by this domain"`
+	TemplateLimit             string `json:"templatelimit,omitempty" doc:"the total number of templates which can be created by this domain"`
+	TemplateTotal             int64  `json:"templatetotal,omitempty" doc:"the total number of templates which have been created by this domain"`
+	VMAvailable               string `json:"vmavailable,omitempty" doc:"the total number of virtual machines available for this domain to acquire"`
+	VMLimit                   string `json:"vmlimit,omitempty" doc:"the total number of virtual machines that can be deployed by this domain"`
+	VMTotal                   int64  `json:"vmtotal,omitempty" doc:"the total number of virtual machines deployed by this domain"`
+	VolumeAvailable           string `json:"volumeavailable,omitempty" doc:"the total volume available for this domain"`
+	VolumeLimit               string `json:"volumelimit,omitempty" doc:"the total volume which can be used by this domain"`
+	VolumeTotal               int64  `json:"volumetotal,omitempty" doc:"the total volume being used by this domain"`
+}
+
+// ListDomains represents a query to display the domains
+//
+// CloudStack API: http://cloudstack.apache.org/api/apidocs-4.10/apis/listDomains.html
+type ListDomains struct {
+	Details  []string `json:"details,omitempty" doc:"comma separated list of domain details requested, value can be a list of [all, resource, min]"`
+	ID       string   `json:"id,omitempty" doc:"List domain by domain ID."`
+	Keyword  string   `json:"keyword,omitempty" doc:"List by keyword"`
+	Level    int      `json:"level,omitempty" doc:"List domains by domain level."`
+	ListAll  *bool    `json:"listall,omitempty" doc:"If set to false, list only resources belonging to the command's caller; if set to true - list resources that the caller is authorized to see. Default value is false"`
+	Name     string   `json:"name,omitempty" doc:"List domain by domain name."`
+	Page     int      `json:"page,omitempty"`
+	PageSize int      `json:"pagesize,omitempty"`
+}
+
+// ListDomainsResponse represents a list of domains
+type ListDomainsResponse struct {
+	Count  int      `json:"count"`
+	Domain []Domain `json:"domain"`
+}
+
+// ListDomainChildren represents a query to display the sub-domains of a domain
+//
+// CloudStack API: http://cloudstack.apache.org/api/apidocs-4.10/apis/listDomainChildren.html
+type ListDomainChildren struct {
+	ID          string `json:"id,omitempty" doc:"list children domain by parent domain ID."`
+	IsRecursive *bool  `json:"isrecursive,omitempty" doc:"to return the entire tree, use the value \"true\". To return the first level children, use the value \"false\"."`
+	Keyword     string `json:"keyword,omitempty" doc:"List by keyword"`
+	ListAll     *bool  `json:"listall,omitempty" doc:"If set to false, list only resources belonging to the command's caller; if set to true - list resources that the caller is authorized to see. Default value is false"`
+	Name        string `json:"name,omitempty" doc:"list children domains by name"`
+	Page        int    `json:"page,omitempty"`
+	PageSize    int    `json:"pagesize,omitempty"`
+}
+
+// ListDomainChildrenResponse represents a list of sub-domains
+type ListDomainChildrenResponse ListDomainsResponse
+
+// CreateDomain represents the creation of a domain
+//
+// CloudStack API: http://cloudstack.apache.org/api/apidocs-4.10/apis/createDomain.html
+type CreateDomain struct {
+	Name           string `json:"name" doc:"creates domain with this name"`
+	DomainID       string `json:"domainid,omitempty" doc:"Domain UUID, required for adding domain from another Region"`
+	NetworkDomain  string `json:"networkdomain,omitempty" doc:"Network domain for networks in the domain"`
+	ParentDomainID string `json:"parentdomainid,omitempty" doc:"assigns new domain a parent domain by domain ID of the parent.  If no parent domain is specied, the ROOT domain is assumed."`
+}
+
+// UpdateDomain represents the modification of a domain
+//
+// CloudStack API: http://cloudstack.apache.org/api/apidocs-4.10/apis/updateDomain.html
+type UpdateDomain struct {
+	ID            string `json:"id" doc:"ID of domain to update"`
+	Name          string `json:"name,omitempty" doc:"updates domain with this name"`
+	NetworkDomain string `json:"networkdomain,omitempty" doc:"Network domain for the domain's networks; empty string will update domainName with NULL value"`
+}
+
+// DeleteDomain (Async) represents the deletion of a domain
+//
+// CloudStack API: http://cloudstack.apache.org/api/apidocs-4.10/apis/deleteDomain.html
+type DeleteDomain struct {
+	ID      string `json:"id" doc:"ID of domain to delete"`
+	Cleanup *bool  `json:"cleanup,omitempty" doc:"true if all domain resources (child domains, accounts) have to be cleaned up, false otherwise"`
+}
@@ -1,5 +1,7 @@
 package egoscale
 
+import "fmt"
+
 func (*ListHosts) name() string {
 	return "listHosts"
 }
@@ -11,3 +13,27 @@ func (*ListHosts) description() string {
 func (*ListHosts) response() interface{} {
 	return new(ListHostsResponse)
 }
+
+// SetPage sets the current page
+func (ls *ListHosts) SetPage(page int) {
+	ls.Page = page
+}
+
+// SetPageSize sets the page size
+func (ls *ListHosts) SetPageSize(pageSize int) {
+	ls.PageSize = pageSize
+}
+
+func (*ListHosts) each(resp interface{}, callback IterateItemFunc) {
+	hosts, ok := resp.(*ListHostsResponse)
+	if !ok {
+		callback(nil, fmt.Errorf("wrong type. ListHostsResponse expected, got %T", resp))
+		return
+	}
+
+	for i := range hosts.Host {
+		if !callback(&hosts.Host[i], nil) {
+			break
+		}
+	}
+}
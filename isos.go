@@ -0,0 +1,146 @@
+package egoscale
+
+import (
+	"context"
+	"fmt"
+)
+
+// ResourceType returns the type of the resource
+func (*ISO) ResourceType() string {
+	return "ISO"
+}
+
+// ResourceID returns the identifier of the resource
+func (iso *ISO) ResourceID() string {
+	return iso.ID
+}
+
+// Delete removes the given ISO from its zone, or from all of them
+func (iso *ISO) Delete(ctx context.Context, client *Client) error {
+	if iso.ID == "" {
+		return fmt.Errorf("an ISO may only be deleted using ID")
+	}
+
+	return client.BooleanRequestWithContext(ctx, &DeleteIso{
+		ID:     iso.ID,
+		ZoneID: iso.ZoneID,
+	})
+}
+
+// ListRequest builds the ListIsos request
+func (iso *ISO) ListRequest() (ListCommand, error) {
+	req := &ListIsos{
+		Account:  iso.Account,
+		DomainID: iso.DomainID,
+		ID:       iso.ID,
+		Name:     iso.Name,
+		Tags:     tagFilter(iso.Tags),
+		ZoneID:   iso.ZoneID,
+	}
+	if iso.Bootable {
+		bootable := true
+		req.Bootable = &bootable
+	}
+	if iso.IsFeatured {
+		req.IsoFilter = "featured"
+	}
+
+	return req, nil
+}
+
+func (*RegisterIso) name() string {
+	return "registerIso"
+}
+
+func (*RegisterIso) description() string {
+	return "Registers an existing ISO into the CloudStack Cloud."
+}
+
+// response is a list as one ISO per zone might be registered
+func (*RegisterIso) response() interface{} {
+	return new(ListIsosResponse)
+}
+
+func (*ListIsos) name() string {
+	return "listIsos"
+}
+
+func (*ListIsos) description() string {
+	return "Lists all available ISO files."
+}
+
+func (*ListIsos) response() interface{} {
+	return new(ListIsosResponse)
+}
+
+// SetPage sets the current page
+func (ls *ListIsos) SetPage(page int) {
+	ls.Page = page
+}
+
+// SetPageSize sets the page size
+func (ls *ListIsos) SetPageSize(pageSize int) {
+	ls.PageSize = pageSize
+}
+
+func (*ListIsos) each(resp interface{}, callback IterateItemFunc) {
+	isos, ok := resp.(*ListIsosResponse)
+	if !ok {
+		callback(nil, fmt.Errorf("wrong type. ListIsosResponse expected, got %T", resp))
+		return
+	}
+
+	for i := range isos.ISO {
+		if !callback(&isos.ISO[i], nil) {
+			break
+		}
+	}
+}
+
+func (*AttachIso) name() string {
+	return "attachIso"
+}
+
+func (*AttachIso) description() string {
+	return "Attaches an ISO to a virtual machine."
+}
+
+func (*AttachIso) asyncResponse() interface{} {
+	return new(VirtualMachine)
+}
+
+func (*DetachIso) name() string {
+	return "detachIso"
+}
+
+func (*DetachIso) description() string {
+	return "Detaches any ISO file (if any) currently attached to a virtual machine."
+}
+
+func (*DetachIso) asyncResponse() interface{} {
+	return new(VirtualMachine)
+}
+
+func (*DeleteIso) name() string {
+	return "deleteIso"
+}
+
+func (*DeleteIso) description() string {
+	return "Deletes an ISO file."
+}
+
+func (*DeleteIso) asyncResponse() interface{} {
+	return new(booleanResponse)
+}
+
+func (*CopyIso) name() string {
+	return "copyIso"
+}
+
+func (*CopyIso) description() string {
+	return "Copies an ISO from one zone to another."
+}
+
+func (*CopyIso) asyncResponse() interface{} {
+	return new(ISO)
+}
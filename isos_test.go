@@ -0,0 +1,99 @@
+package egoscale
+
+import (
+	"testing"
+)
+
+func TestISO(t *testing.T) {
+	instance := &ISO{}
+	if instance.ResourceType() != "ISO" {
+		t.Errorf("ResourceType doesn't match")
+	}
+}
+
+func TestRegisterIso(t *testing.T) {
+	req := &RegisterIso{}
+	if req.name() != "registerIso" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.response().(*ListIsosResponse)
+}
+
+func TestListIsos(t *testing.T) {
+	req := &ListIsos{}
+	if req.name() != "listIsos" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.response().(*ListIsosResponse)
+}
+
+func TestAttachIso(t *testing.T) {
+	req := &AttachIso{}
+	if req.name() != "attachIso" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.asyncResponse().(*VirtualMachine)
+}
+
+func TestDetachIso(t *testing.T) {
+	req := &DetachIso{}
+	if req.name() != "detachIso" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.asyncResponse().(*VirtualMachine)
+}
+
+func TestDeleteIso(t *testing.T) {
+	req := &DeleteIso{}
+	if req.name() != "deleteIso" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.asyncResponse().(*booleanResponse)
+}
+
+func TestCopyIso(t *testing.T) {
+	req := &CopyIso{}
+	if req.name() != "copyIso" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.asyncResponse().(*ISO)
+}
+
+func TestGetISO(t *testing.T) {
+	ts := newServer(response{200, jsonContentType, `
+{"listisosresponse": {
+	"count": 1,
+	"iso": [
+		{
+			"account": "system",
+			"bootable": true,
+			"created": "2018-05-04T14:31:11+0200",
+			"crossZones": false,
+			"displaytext": "SystemRescueCd 5.2.2",
+			"id": "d2a9b3c4-6e5f-4a1b-8c7d-0e1f2a3b4c5d",
+			"isfeatured": true,
+			"ispublic": true,
+			"isready": true,
+			"name": "systemrescuecd-5.2.2",
+			"ostypeid": "bf3a2b62-1f6a-4b5d-8b49-6c0e3a0fd2b1",
+			"ostypename": "Other Linux (64-bit)",
+			"size": 601882624,
+			"status": "Successfully Installed",
+			"zoneid": "1747ef5e-5451-41fd-9f1a-58913bae9702",
+			"zonename": "ch-gva-2"
+		}
+	]
+}}`})
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+
+	iso := &ISO{Name: "systemrescuecd-5.2.2", IsFeatured: true}
+	if err := cs.Get(iso); err != nil {
+		t.Fatal(err)
+	}
+
+	if !iso.Bootable || iso.ID != "d2a9b3c4-6e5f-4a1b-8c7d-0e1f2a3b4c5d" {
+		t.Errorf("bad ISO, got %#v", iso)
+	}
+}
@@ -0,0 +1,113 @@
+package egoscale
+
+// ISO represents an attachable ISO disc
+type ISO struct {
+	Account          string        `json:"account,omitempty" doc:"the account name to which the ISO belongs"`
+	AccountID        string        `json:"accountid,omitempty" doc:"the account id to which the ISO belongs"`
+	Bootable         bool          `json:"bootable,omitempty" doc:"true if the ISO is bootable, false otherwise"`
+	Checksum         string        `json:"checksum,omitempty" doc:"checksum of the ISO"`
+	Created          string        `json:"created,omitempty" doc:"the date this ISO was created"`
+	CrossZones       bool          `json:"crossZones,omitempty" doc:"true if the ISO is managed across all Zones, false otherwise"`
+	DisplayText      string        `json:"displaytext,omitempty" doc:"the ISO display text"`
+	Domain           string        `json:"domain,omitempty" doc:"the name of the domain to which the ISO belongs"`
+	DomainID         string        `json:"domainid,omitempty" doc:"the ID of the domain to which the ISO belongs"`
+	Format           string        `json:"format,omitempty" doc:"the format of the ISO."`
+	ID               string        `json:"id,omitempty" doc:"the ISO ID"`
+	IsExtractable    bool          `json:"isextractable,omitempty" doc:"true if the ISO is extractable, false otherwise"`
+	IsFeatured       bool          `json:"isfeatured,omitempty" doc:"true if this ISO is a featured ISO, false otherwise"`
+	IsPublic         bool          `json:"ispublic,omitempty" doc:"true if this ISO is a public ISO, false otherwise"`
+	IsReady          bool          `json:"isready,omitempty" doc:"true if the ISO is ready to be attached, false otherwise."`
+	Name             string        `json:"name,omitempty" doc:"the ISO name"`
+	OsTypeID         string        `json:"ostypeid,omitempty" doc:"the ID of the OS type for this ISO."`
+	OsTypeName       string        `json:"ostypename,omitempty" doc:"the name of the OS type for this ISO."`
+	PasswordEnabled  bool          `json:"passwordenabled,omitempty" doc:"true if the reset password feature is enabled, false otherwise"`
+	Removed          string        `json:"removed,omitempty" doc:"the date this ISO was removed"`
+	Size             int64         `json:"size,omitempty" doc:"the size of the ISO"`
+	SourceTemplateID string        `json:"sourcetemplateid,omitempty" doc:"the ID of the parent template if present"`
+	Status           string        `json:"status,omitempty" doc:"the status of the ISO"`
+	Tags             []ResourceTag `json:"tags,omitempty" doc:"the list of resource tags associated with the ISO"`
+	URL              string        `json:"url,omitempty" doc:"Original URL of the ISO where it was downloaded"`
+	ZoneID           string        `json:"zoneid,omitempty" doc:"the ID of the zone for this ISO"`
+	ZoneName         string        `json:"zonename,omitempty" doc:"the name of the zone for this ISO"`
+}
+
+// RegisterIso registers an existing ISO
+//
+// CloudStackAPI: http://cloudstack.apache.org/api/apidocs-4.10/apis/registerIso.html
+type RegisterIso struct {
+	DisplayText           string `json:"displaytext" doc:"the display text of the ISO. This is usually used for display purposes."`
+	Name                  string `json:"name" doc:"the name of the ISO"`
+	URL                   string `json:"url" doc:"the URL to where the ISO is currently being hosted"`
+	ZoneID                string `json:"zoneid" doc:"the ID of the zone you wish to register the ISO to."`
+	Account               string `json:"account,omitempty" doc:"an optional account name. Must be used with domainId."`
+	Bootable              *bool  `json:"bootable,omitempty" doc:"true if this ISO is bootable. If not passed explicitly its assumed to be true"`
+	Checksum              string `json:"checksum,omitempty" doc:"the MD5 checksum value of this ISO"`
+	DomainID              string `json:"domainid,omitempty" doc:"an optional domainId. If the account parameter is used, domainId must also be used."`
+	ImageStoreUUID        string `json:"imagestoreuuid,omitempty" doc:"Image store UUID"`
+	IsDynamicallyScalable *bool  `json:"isdynamicallyscalable,omitempty" doc:"true if ISO contains XS/VMWare tools inorder to support dynamic scaling of VM CPU/memory"`
+	IsExtractable         *bool  `json:"isextractable,omitempty" doc:"true if the ISO or its derivatives are extractable; default is false"`
+	IsFeatured            *bool  `json:"isfeatured,omitempty" doc:"true if you want this ISO to be featured"`
+	IsPublic              *bool  `json:"ispublic,omitempty" doc:"true if you want to register the ISO to be publicly available to all users, false otherwise."`
+	OsTypeID              string `json:"ostypeid,omitempty" doc:"the ID of the OS type that best represents the OS of this ISO. If the ISO is bootable this parameter needs to be passed"`
+}
+
+// ListIsos lists all available ISO files
+//
+// CloudStackAPI: http://cloudstack.apache.org/api/apidocs-4.10/apis/listIsos.html
+type ListIsos struct {
+	Account     string        `json:"account,omitempty" doc:"list resources by account. Must be used with the domainId parameter."`
+	Bootable    *bool         `json:"bootable,omitempty" doc:"true if the ISO is bootable, false otherwise"`
+	DomainID    string        `json:"domainid,omitempty" doc:"list only resources belonging to the domain specified"`
+	Hypervisor  string        `json:"hypervisor,omitempty" doc:"the hypervisor for which to restrict the search"`
+	ID          string        `json:"id,omitempty" doc:"list ISO by ID"`
+	IsoFilter   string        `json:"isofilter,omitempty" enum:"featured,self,selfexecutable,sharedexecutable,executable,community,all" doc:"possible values are \"featured\", \"self\", \"selfexecutable\",\"sharedexecutable\",\"executable\", and \"community\". * featured : ISOs that have been marked as featured and public. * self : ISOs that have been registered or created by the calling user. * selfexecutable : same as self, but only returns ISOs that are ready to be deployed with. * sharedexecutable : ISOs that have been granted to the calling user by another user. * executable : ISOs that are owned by the calling user, or public ISOs, that can be used to deploy a new VM. * community : ISOs that have been marked as public but not featured. * all : all ISOs (only usable by admins)."`
+	IsPublic    *bool         `json:"ispublic,omitempty" doc:"true if the ISO is publicly available to all users, false otherwise."`
+	IsReady     *bool         `json:"isready,omitempty" doc:"true if this ISO is ready to be deployed"`
+	IsRecursive *bool         `json:"isrecursive,omitempty" doc:"defaults to false, but if true, lists all resources from the parent specified by the domainId till leaves."`
+	Keyword     string        `json:"keyword,omitempty" doc:"List by keyword"`
+	ListAll     *bool         `json:"listall,omitempty" doc:"If set to false, list only resources belonging to the command's caller; if set to true - list resources that the caller is authorized to see. Default value is false"`
+	Name        string        `json:"name,omitempty" doc:"list all ISOs by name"`
+	Page        int           `json:"page,omitempty"`
+	PageSize    int           `json:"pagesize,omitempty"`
+	ShowRemoved *bool         `json:"showremoved,omitempty" doc:"show removed ISOs as well"`
+	Tags        []ResourceTag `json:"tags,omitempty" doc:"List resources by tags (key/value pairs)"`
+	ZoneID      string        `json:"zoneid,omitempty" doc:"the ID of the zone"`
+}
+
+// ListIsosResponse represents a list of ISO files
+type ListIsosResponse struct {
+	Count int   `json:"count"`
+	ISO   []ISO `json:"iso"`
+}
+
+// AttachIso (Async) attaches an ISO to a virtual machine
+//
+// CloudStackAPI: http://cloudstack.apache.org/api/apidocs-4.10/apis/attachIso.html
+type AttachIso struct {
+	ID               string `json:"id" doc:"the ID of the ISO file"`
+	VirtualMachineID string `json:"virtualmachineid" doc:"the ID of the virtual machine"`
+}
+
+// DetachIso (Async) detaches any ISO file (if any) currently attached to a virtual machine
+//
+// CloudStackAPI: http://cloudstack.apache.org/api/apidocs-4.10/apis/detachIso.html
+type DetachIso struct {
+	VirtualMachineID string `json:"virtualmachineid" doc:"The ID of the virtual machine"`
+}
+
+// DeleteIso (Async) deletes an ISO file
+//
+// CloudStackAPI: http://cloudstack.apache.org/api/apidocs-4.10/apis/deleteIso.html
+type DeleteIso struct {
+	ID     string `json:"id" doc:"the ID of the ISO file"`
+	ZoneID string `json:"zoneid,omitempty" doc:"the ID of the zone of the ISO file. If not specified, the ISO will be deleted from all the zones"`
+}
+
+// CopyIso (Async) copies an ISO from one zone to another
+//
+// CloudStackAPI: http://cloudstack.apache.org/api/apidocs-4.10/apis/copyIso.html
+type CopyIso struct {
+	ID           string `json:"id" doc:"the ID of the ISO file"`
+	DestZoneID   string `json:"destzoneid" doc:"ID of the zone the ISO is being copied to."`
+	SourceZoneID string `json:"sourcezoneid,omitempty" doc:"ID of the zone the ISO is currently hosted on. If not specified and ISO is cross-zone, then we will sync this ISO to region wide image store."`
+}
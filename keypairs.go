@@ -30,6 +30,48 @@ func (ssh *SSHKeyPair) ListRequest() (ListCommand, error) {
 	return req, nil
 }
 
+// SSHKeyPairByFingerprint finds the registered key pair having the given fingerprint
+func (client *Client) SSHKeyPairByFingerprint(ctx context.Context, fingerprint string) (*SSHKeyPair, error) {
+	ssh := &SSHKeyPair{
+		Fingerprint: fingerprint,
+	}
+
+	if err := client.GetWithContext(ctx, ssh); err != nil {
+		return nil, err
+	}
+
+	return ssh, nil
+}
+
+// RegisterSSHKeyPairIfAbsent registers the public key unless it already is, whatever its name
+//
+// The existing key pair is returned if the public key is already known.
+func (client *Client) RegisterSSHKeyPairIfAbsent(ctx context.Context, name, publicKey string) (*SSHKeyPair, error) {
+	fingerprint, err := SSHKeyFingerprint(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	sshs, err := client.ListWithContext(ctx, &SSHKeyPair{Fingerprint: fingerprint})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sshs) > 0 {
+		return sshs[0].(*SSHKeyPair), nil
+	}
+
+	resp, err := client.RequestWithContext(ctx, &RegisterSSHKeyPair{
+		Name:      name,
+		PublicKey: publicKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*SSHKeyPair), nil
+}
+
 func (*CreateSSHKeyPair) name() string {
 	return "createSSHKeyPair"
 }
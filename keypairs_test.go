@@ -1,6 +1,8 @@
 package egoscale
 
 import (
+	"context"
+	"net/url"
 	"testing"
 )
 
@@ -171,3 +173,71 @@ func TestGetSSHKeyPairNotFound(t *testing.T) {
 		t.Errorf("An error was expected")
 	}
 }
+
+func TestRegisterSSHKeyPairIfAbsentExisting(t *testing.T) {
+	publicKey := "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIBjwGTdtJomMSPo0NhRYtlQbODdQ/rvVM/duUKCGABfM test"
+
+	var queries []url.Values
+	ts := newRecordingServer(&queries, response{200, jsonContentType, `
+{"listsshkeypairsresponse": {
+	"count": 1,
+	"sshkeypair": [
+		{
+			"fingerprint": "6c:06:cd:3b:17:a3:39:a9:de:b2:f3:63:46:87:b9:b8",
+			"name": "laptop"
+		}
+	]
+}}`})
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+	ssh, err := cs.RegisterSSHKeyPairIfAbsent(context.Background(), "desktop", publicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ssh.Name != "laptop" {
+		t.Errorf("the existing key pair was expected, got %q", ssh.Name)
+	}
+
+	if len(queries) != 1 {
+		t.Fatalf("one request was expected, got %d", len(queries))
+	}
+
+	if queries[0].Get("fingerprint") != "6c:06:cd:3b:17:a3:39:a9:de:b2:f3:63:46:87:b9:b8" {
+		t.Errorf("fingerprint doesn't match, got %q", queries[0].Get("fingerprint"))
+	}
+}
+
+func TestRegisterSSHKeyPairIfAbsentMissing(t *testing.T) {
+	publicKey := "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIBjwGTdtJomMSPo0NhRYtlQbODdQ/rvVM/duUKCGABfM test"
+
+	var queries []url.Values
+	ts := newRecordingServer(&queries, response{200, jsonContentType, `
+{"listsshkeypairsresponse": {}}`}, response{200, jsonContentType, `
+{"registersshkeypairresponse": {
+	"keypair": {
+		"fingerprint": "6c:06:cd:3b:17:a3:39:a9:de:b2:f3:63:46:87:b9:b8",
+		"name": "desktop"
+	}
+}}`})
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+	ssh, err := cs.RegisterSSHKeyPairIfAbsent(context.Background(), "desktop", publicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ssh.Name != "desktop" {
+		t.Errorf("the new key pair was expected, got %q", ssh.Name)
+	}
+
+	if len(queries) != 2 {
+		t.Fatalf("two requests were expected, got %d", len(queries))
+	}
+
+	if queries[1].Get("command") != "registerSSHKeyPair" || queries[1].Get("publickey") != publicKey {
+		t.Errorf("register request doesn't match, got %v", queries[1])
+	}
+}
@@ -0,0 +1,235 @@
+package egoscale
+
+import (
+	"context"
+	"fmt"
+)
+
+// ResourceType returns the type of the resource
+func (*LoadBalancerRule) ResourceType() string {
+	return "LoadBalancer"
+}
+
+// ResourceID returns the identifier of the resource
+func (lb *LoadBalancerRule) ResourceID() string {
+	return lb.ID
+}
+
+// Delete removes the given load balancer rule
+func (lb *LoadBalancerRule) Delete(ctx context.Context, client *Client) error {
+	if lb.ID == "" {
+		return fmt.Errorf("a LoadBalancerRule may only be deleted using ID")
+	}
+
+	return client.BooleanRequestWithContext(ctx, &DeleteLoadBalancerRule{
+		ID: lb.ID,
+	})
+}
+
+// ListRequest builds the ListLoadBalancerRules request
+func (lb *LoadBalancerRule) ListRequest() (ListCommand, error) {
+	req := &ListLoadBalancerRules{
+		Account:    lb.Account,
+		DomainID:   lb.DomainID,
+		ID:         lb.ID,
+		Name:       lb.Name,
+		NetworkID:  lb.NetworkID,
+		PublicIPID: lb.PublicIPID,
+		Tags:       tagFilter(lb.Tags),
+		ZoneID:     lb.ZoneID,
+	}
+
+	return req, nil
+}
+
+func (*CreateLoadBalancerRule) name() string {
+	return "createLoadBalancerRule"
+}
+
+func (*CreateLoadBalancerRule) description() string {
+	return "Creates a load balancer rule"
+}
+
+func (*CreateLoadBalancerRule) asyncResponse() interface{} {
+	return new(LoadBalancerRule)
+}
+
+func (*UpdateLoadBalancerRule) name() string {
+	return "updateLoadBalancerRule"
+}
+
+func (*UpdateLoadBalancerRule) description() string {
+	return "Updates load balancer"
+}
+
+func (*UpdateLoadBalancerRule) asyncResponse() interface{} {
+	return new(LoadBalancerRule)
+}
+
+func (*DeleteLoadBalancerRule) name() string {
+	return "deleteLoadBalancerRule"
+}
+
+func (*DeleteLoadBalancerRule) description() string {
+	return "Deletes a load balancer rule."
+}
+
+func (*DeleteLoadBalancerRule) asyncResponse() interface{} {
+	return new(booleanResponse)
+}
+
+func (*ListLoadBalancerRules) name() string {
+	return "listLoadBalancerRules"
+}
+
+func (*ListLoadBalancerRules) description() string {
+	return "Lists load balancer rules."
+}
+
+func (*ListLoadBalancerRules) response() interface{} {
+	return new(ListLoadBalancerRulesResponse)
+}
+
+// SetPage sets the current page
+func (ls *ListLoadBalancerRules) SetPage(page int) {
+	ls.Page = page
+}
+
+// SetPageSize sets the page size
+func (ls *ListLoadBalancerRules) SetPageSize(pageSize int) {
+	ls.PageSize = pageSize
+}
+
+func (*ListLoadBalancerRules) each(resp interface{}, callback IterateItemFunc) {
+	lbs, ok := resp.(*ListLoadBalancerRulesResponse)
+	if !ok {
+		callback(nil, fmt.Errorf("wrong type. ListLoadBalancerRulesResponse expected, got %T", resp))
+		return
+	}
+
+	for i := range lbs.LoadBalancerRule {
+		if !callback(&lbs.LoadBalancerRule[i], nil) {
+			break
+		}
+	}
+}
+
+func (*AssignToLoadBalancerRule) name() string {
+	return "assignToLoadBalancerRule"
+}
+
+func (*AssignToLoadBalancerRule) description() string {
+	return "Assigns virtual machine or a list of virtual machines to a load balancer rule."
+}
+
+func (*AssignToLoadBalancerRule) asyncResponse() interface{} {
+	return new(booleanResponse)
+}
+
+func (*RemoveFromLoadBalancerRule) name() string {
+	return "removeFromLoadBalancerRule"
+}
+
+func (*RemoveFromLoadBalancerRule) description() string {
+	return "Removes a virtual machine or a list of virtual machines from a load balancer rule."
+}
+
+func (*RemoveFromLoadBalancerRule) asyncResponse() interface{} {
+	return new(booleanResponse)
+}
+
+func (*ListLoadBalancerRuleInstances) name() string {
+	return "listLoadBalancerRuleInstances"
+}
+
+func (*ListLoadBalancerRuleInstances) description() string {
+	return "List all virtual machine instances that are assigned to a load balancer rule."
+}
+
+func (*ListLoadBalancerRuleInstances) response() interface{} {
+	return new(ListLoadBalancerRuleInstancesResponse)
+}
+
+// SetPage sets the current page
+func (ls *ListLoadBalancerRuleInstances) SetPage(page int) {
+	ls.Page = page
+}
+
+// SetPageSize sets the page size
+func (ls *ListLoadBalancerRuleInstances) SetPageSize(pageSize int) {
+	ls.PageSize = pageSize
+}
+
+func (*ListLoadBalancerRuleInstances) each(resp interface{}, callback IterateItemFunc) {
+	vms, ok := resp.(*ListLoadBalancerRuleInstancesResponse)
+	if !ok {
+		callback(nil, fmt.Errorf("wrong type. ListLoadBalancerRuleInstancesResponse expected, got %T", resp))
+		return
+	}
+
+	for i := range vms.LoadBalancerRuleInstance {
+		if !callback(&vms.LoadBalancerRuleInstance[i], nil) {
+			break
+		}
+	}
+}
+
+func (*CreateLBHealthCheckPolicy) name() string {
+	return "createLBHealthCheckPolicy"
+}
+
+func (*CreateLBHealthCheckPolicy) description() string {
+	return "Creates a load balancer health check policy"
+}
+
+func (*CreateLBHealthCheckPolicy) asyncResponse() interface{} {
+	return new(LBHealthCheck)
+}
+
+func (*DeleteLBHealthCheckPolicy) name() string {
+	return "deleteLBHealthCheckPolicy"
+}
+
+func (*DeleteLBHealthCheckPolicy) description() string {
+	return "Deletes a load balancer health check policy."
+}
+
+func (*DeleteLBHealthCheckPolicy) asyncResponse() interface{} {
+	return new(booleanResponse)
+}
+
+func (*ListLBHealthCheckPolicies) name() string {
+	return "listLBHealthCheckPolicies"
+}
+
+func (*ListLBHealthCheckPolicies) description() string {
+	return "Lists load balancer health check policies."
+}
+
+func (*ListLBHealthCheckPolicies) response() interface{} {
+	return new(ListLBHealthCheckPoliciesResponse)
+}
+
+// SetPage sets the current page
+func (ls *ListLBHealthCheckPolicies) SetPage(page int) {
+	ls.Page = page
+}
+
+// SetPageSize sets the page size
+func (ls *ListLBHealthCheckPolicies) SetPageSize(pageSize int) {
+	ls.PageSize = pageSize
+}
+
+func (*ListLBHealthCheckPolicies) each(resp interface{}, callback IterateItemFunc) {
+	policies, ok := resp.(*ListLBHealthCheckPoliciesResponse)
+	if !ok {
+		callback(nil, fmt.Errorf("wrong type. ListLBHealthCheckPoliciesResponse expected, got %T", resp))
+		return
+	}
+
+	for i := range policies.HealthCheckPolicies {
+		if !callback(&policies.HealthCheckPolicies[i], nil) {
+			break
+		}
+	}
+}
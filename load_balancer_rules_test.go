@@ -0,0 +1,199 @@
+package egoscale
+
+import (
+	"testing"
+)
+
+func TestLoadBalancerRule(t *testing.T) {
+	instance := &LoadBalancerRule{}
+	if instance.ResourceType() != "LoadBalancer" {
+		t.Errorf("ResourceType doesn't match")
+	}
+}
+
+func TestCreateLoadBalancerRule(t *testing.T) {
+	req := &CreateLoadBalancerRule{}
+	if req.name() != "createLoadBalancerRule" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.asyncResponse().(*LoadBalancerRule)
+}
+
+func TestUpdateLoadBalancerRule(t *testing.T) {
+	req := &UpdateLoadBalancerRule{}
+	if req.name() != "updateLoadBalancerRule" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.asyncResponse().(*LoadBalancerRule)
+}
+
+func TestDeleteLoadBalancerRule(t *testing.T) {
+	req := &DeleteLoadBalancerRule{}
+	if req.name() != "deleteLoadBalancerRule" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.asyncResponse().(*booleanResponse)
+}
+
+func TestListLoadBalancerRules(t *testing.T) {
+	req := &ListLoadBalancerRules{}
+	if req.name() != "listLoadBalancerRules" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.response().(*ListLoadBalancerRulesResponse)
+}
+
+func TestAssignToLoadBalancerRule(t *testing.T) {
+	req := &AssignToLoadBalancerRule{}
+	if req.name() != "assignToLoadBalancerRule" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.asyncResponse().(*booleanResponse)
+}
+
+func TestRemoveFromLoadBalancerRule(t *testing.T) {
+	req := &RemoveFromLoadBalancerRule{}
+	if req.name() != "removeFromLoadBalancerRule" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.asyncResponse().(*booleanResponse)
+}
+
+func TestListLoadBalancerRuleInstances(t *testing.T) {
+	req := &ListLoadBalancerRuleInstances{}
+	if req.name() != "listLoadBalancerRuleInstances" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.response().(*ListLoadBalancerRuleInstancesResponse)
+}
+
+func TestCreateLBHealthCheckPolicy(t *testing.T) {
+	req := &CreateLBHealthCheckPolicy{}
+	if req.name() != "createLBHealthCheckPolicy" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.asyncResponse().(*LBHealthCheck)
+}
+
+func TestDeleteLBHealthCheckPolicy(t *testing.T) {
+	req := &DeleteLBHealthCheckPolicy{}
+	if req.name() != "deleteLBHealthCheckPolicy" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.asyncResponse().(*booleanResponse)
+}
+
+func TestListLBHealthCheckPolicies(t *testing.T) {
+	req := &ListLBHealthCheckPolicies{}
+	if req.name() != "listLBHealthCheckPolicies" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.response().(*ListLBHealthCheckPoliciesResponse)
+}
+
+func TestGetLoadBalancerRule(t *testing.T) {
+	ts := newServer(response{200, jsonContentType, `
+{"listloadbalancerrulesresponse": {
+	"count": 1,
+	"loadbalancerrule": [
+		{
+			"account": "test",
+			"algorithm": "roundrobin",
+			"cidrlist": "",
+			"fordisplay": true,
+			"id": "e3b1f8a2-7c4d-4e6f-9a0b-1c2d3e4f5a6b",
+			"name": "web",
+			"networkid": "00304a04-c7ea-4e77-a786-18bc64347bf7",
+			"privateport": "8080",
+			"protocol": "tcp",
+			"publicip": "159.100.240.12",
+			"publicipid": "a0f2c7e1-3b5d-4c6e-8f9a-0b1c2d3e4f5a",
+			"publicport": "80",
+			"state": "Active",
+			"tags": [],
+			"zoneid": "1747ef5e-5451-41fd-9f1a-58913bae9702"
+		}
+	]
+}}`})
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+
+	lb := &LoadBalancerRule{Name: "web"}
+	if err := cs.Get(lb); err != nil {
+		t.Fatal(err)
+	}
+
+	if lb.PublicPort != 80 || lb.PrivatePort != 8080 {
+		t.Errorf("bad ports, got %d -> %d", lb.PublicPort, lb.PrivatePort)
+	}
+}
+
+func TestListLoadBalancerRuleInstancesPaginate(t *testing.T) {
+	ts := newServer(response{200, jsonContentType, `
+{"listloadbalancerruleinstancesresponse": {
+	"count": 2,
+	"loadbalancerruleinstance": [
+		{"id": "9ccc3d5b-9dce-4302-a955-24b80b402f88", "name": "web-1", "state": "Running"},
+		{"id": "4f2c1b7a-0d3e-4a5b-8c6d-7e8f9a0b1c2d", "name": "web-2", "state": "Running"}
+	]
+}}`})
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+
+	names := make([]string, 0)
+	cs.Paginate(&ListLoadBalancerRuleInstances{ID: "e3b1f8a2-7c4d-4e6f-9a0b-1c2d3e4f5a6b"}, func(item interface{}, err error) bool {
+		if err != nil {
+			t.Error(err)
+			return false
+		}
+		names = append(names, item.(*VirtualMachine).Name)
+		return true
+	})
+
+	if len(names) != 2 || names[1] != "web-2" {
+		t.Errorf("bad instances, got %v", names)
+	}
+}
+
+func TestCreateLBHealthCheckPolicyResponse(t *testing.T) {
+	ts := newServer(response{200, jsonContentType, `
+{"createlbhealthcheckpolicyresponse": {
+	"jobid": "1",
+	"jobresult": {
+		"healthcheckpolicies": {
+			"account": "test",
+			"healthcheckpolicy": [
+				{
+					"healthcheckinterval": 5,
+					"healthcheckthresshold": 2,
+					"id": "7b0f2e1d-4c3a-4b5e-9d8f-0a1b2c3d4e5f",
+					"pingpath": "/health",
+					"responsetime": 2,
+					"state": "Add",
+					"unhealthcheckthresshold": 3
+				}
+			],
+			"lbruleid": "e3b1f8a2-7c4d-4e6f-9a0b-1c2d3e4f5a6b"
+		}
+	},
+	"jobstatus": 1
+}}`})
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+
+	resp, err := cs.Request(&CreateLBHealthCheckPolicy{
+		LBRuleID: "e3b1f8a2-7c4d-4e6f-9a0b-1c2d3e4f5a6b",
+		PingPath: "/health",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hc := resp.(*LBHealthCheck)
+	if len(hc.HealthCheckPolicy) != 1 || hc.HealthCheckPolicy[0].PingPath != "/health" {
+		t.Errorf("bad health check, got %#v", hc)
+	}
+}
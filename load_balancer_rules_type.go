@@ -0,0 +1,186 @@
+package egoscale
+
+// LoadBalancerRule represents a load balancer rule, spreading the traffic of a public port among virtual machines
+type LoadBalancerRule struct {
+	Account     string        `json:"account,omitempty" doc:"the account of the load balancer rule"`
+	Algorithm   string        `json:"algorithm,omitempty" doc:"the load balancer algorithm (source, roundrobin, leastconn)"`
+	CidrList    string        `json:"cidrlist,omitempty" doc:"the cidr list to forward traffic from, comma separated"`
+	Description string        `json:"description,omitempty" doc:"the description of the load balancer"`
+	Domain      string        `json:"domain,omitempty" doc:"the domain of the load balancer rule"`
+	DomainID    string        `json:"domainid,omitempty" doc:"the domain ID of the load balancer rule"`
+	ForDisplay  bool          `json:"fordisplay,omitempty" doc:"is rule for display to the regular user"`
+	ID          string        `json:"id,omitempty" doc:"the load balancer rule ID"`
+	Name        string        `json:"name,omitempty" doc:"the name of the load balancer"`
+	NetworkID   string        `json:"networkid,omitempty" doc:"the id of the guest network the lb rule belongs to"`
+	PrivatePort uint16        `json:"privateport,omitempty,string" doc:"the private port"`
+	Protocol    string        `json:"protocol,omitempty" doc:"the protocol of the loadbalanacer rule"`
+	PublicIP    string        `json:"publicip,omitempty" doc:"the public ip address"`
+	PublicIPID  string        `json:"publicipid,omitempty" doc:"the public ip address id"`
+	PublicPort  uint16        `json:"publicport,omitempty,string" doc:"the public port"`
+	State       string        `json:"state,omitempty" doc:"the state of the rule"`
+	Tags        []ResourceTag `json:"tags,omitempty" doc:"the list of resource tags associated with load balancer"`
+	ZoneID      string        `json:"zoneid,omitempty" doc:"the id of the zone the rule belongs to"`
+	ZoneName    string        `json:"zonename,omitempty" doc:"the name of the zone the load balancer rule belongs to"`
+}
+
+// LBHealthCheckPolicy represents a health check of the members of a load balancer rule
+type LBHealthCheckPolicy struct {
+	Description         string `json:"description,omitempty" doc:"the description of the healthcheck policy"`
+	ForDisplay          bool   `json:"fordisplay,omitempty" doc:"is policy for display to the regular user"`
+	HealthCheckInterval int    `json:"healthcheckinterval,omitempty" doc:"Amount of time between health checks"`
+	HealthyThreshold    int    `json:"healthcheckthresshold,omitempty" doc:"Number of consecutive health check success before declaring an instance healthy"`
+	ID                  string `json:"id,omitempty" doc:"the LB HealthCheck policy ID"`
+	PingPath            string `json:"pingpath,omitempty" doc:"the pingpath  of the healthcheck policy"`
+	ResponseTime        int    `json:"responsetime,omitempty" doc:"Time to wait when receiving a response from the health check"`
+	State               string `json:"state,omitempty" doc:"the state of the policy"`
+	UnhealthyThreshold  int    `json:"unhealthcheckthresshold,omitempty" doc:"Number of consecutive health check failures before declaring an instance unhealthy."`
+}
+
+// LBHealthCheck represents the health check policies of a load balancer rule
+type LBHealthCheck struct {
+	Account           string                `json:"account,omitempty" doc:"the account of the HealthCheck policy"`
+	Domain            string                `json:"domain,omitempty" doc:"the domain of the HealthCheck policy"`
+	DomainID          string                `json:"domainid,omitempty" doc:"the domain ID of the HealthCheck policy"`
+	HealthCheckPolicy []LBHealthCheckPolicy `json:"healthcheckpolicy,omitempty" doc:"the list of healthcheckpolicies"`
+	LBRuleID          string                `json:"lbruleid,omitempty" doc:"the LB rule ID"`
+	ZoneID            string                `json:"zoneid,omitempty" doc:"the id of the zone the HealthCheck policy belongs to"`
+}
+
+// CreateLoadBalancerRule (Async) represents the creation of a load balancer rule
+//
+// CloudStack API: https://cloudstack.apache.org/api/apidocs-4.10/apis/createLoadBalancerRule.html
+type CreateLoadBalancerRule struct {
+	Algorithm    string   `json:"algorithm" enum:"source,roundrobin,leastconn" doc:"load balancer algorithm (source, roundrobin, leastconn)"`
+	Name         string   `json:"name" doc:"name of the load balancer rule"`
+	PrivatePort  uint16   `json:"privateport" doc:"the private port of the private IP address/virtual machine where the network traffic will be load balanced to"`
+	PublicPort   uint16   `json:"publicport" doc:"the public port from where the network traffic will be load balanced from"`
+	Account      string   `json:"account,omitempty" doc:"the account associated with the load balancer. Must be used with the domainId parameter."`
+	CidrList     []string `json:"cidrlist,omitempty" doc:"the CIDR list to forward traffic from"`
+	Description  string   `json:"description,omitempty" doc:"the description of the load balancer rule"`
+	DomainID     string   `json:"domainid,omitempty" doc:"the domain ID associated with the load balancer"`
+	ForDisplay   *bool    `json:"fordisplay,omitempty" doc:"an optional field, whether to the display the rule to the end user or not"`
+	NetworkID    string   `json:"networkid,omitempty" doc:"The guest network this rule will be created for. Required when public Ip address is not associated with any Guest network yet (VPC case)"`
+	OpenFirewall *bool    `json:"openfirewall,omitempty" doc:"if true, firewall rule for source/end public port is automatically created; if false - firewall rule has to be created explicitly. If not specified 1) defaulted to false when LB rule is being created for VPC guest network 2) in all other cases defaulted to true"`
+	Protocol     string   `json:"protocol,omitempty" doc:"The protocol for the LB"`
+	PublicIPID   string   `json:"publicipid,omitempty" doc:"public IP address ID from where the network traffic will be load balanced from"`
+	ZoneID       string   `json:"zoneid,omitempty" doc:"zone where the load balancer is going to be created. This parameter is required when LB service provider is ElasticLoadBalancerVm"`
+}
+
+// UpdateLoadBalancerRule (Async) represents the modification of a load balancer rule
+//
+// CloudStack API: https://cloudstack.apache.org/api/apidocs-4.10/apis/updateLoadBalancerRule.html
+type UpdateLoadBalancerRule struct {
+	ID          string `json:"id" doc:"the ID of the load balancer rule to update"`
+	Algorithm   string `json:"algorithm,omitempty" enum:"source,roundrobin,leastconn" doc:"load balancer algorithm (source, roundrobin, leastconn)"`
+	CustomID    string `json:"customid,omitempty" doc:"an optional field, in case you want to set a custom id to the resource. Allowed to Root Admins only"`
+	Description string `json:"description,omitempty" doc:"the description of the load balancer rule"`
+	ForDisplay  *bool  `json:"fordisplay,omitempty" doc:"an optional field, whether to the display the rule to the end user or not"`
+	Name        string `json:"name,omitempty" doc:"the name of the load balancer rule"`
+	Protocol    string `json:"protocol,omitempty" doc:"The protocol for the LB"`
+}
+
+// DeleteLoadBalancerRule (Async) represents the deletion of a load balancer rule
+//
+// CloudStack API: https://cloudstack.apache.org/api/apidocs-4.10/apis/deleteLoadBalancerRule.html
+type DeleteLoadBalancerRule struct {
+	ID string `json:"id" doc:"the ID of the load balancer rule"`
+}
+
+// ListLoadBalancerRules represents a search for load balancer rules
+//
+// CloudStack API: https://cloudstack.apache.org/api/apidocs-4.10/apis/listLoadBalancerRules.html
+type ListLoadBalancerRules struct {
+	Account          string        `json:"account,omitempty" doc:"list resources by account. Must be used with the domainId parameter."`
+	DomainID         string        `json:"domainid,omitempty" doc:"list only resources belonging to the domain specified"`
+	ForDisplay       *bool         `json:"fordisplay,omitempty" doc:"list resources by display flag; only ROOT admin is eligible to pass this parameter"`
+	ID               string        `json:"id,omitempty" doc:"the ID of the load balancer rule"`
+	IsRecursive      *bool         `json:"isrecursive,omitempty" doc:"defaults to false, but if true, lists all resources from the parent specified by the domainId till leaves."`
+	Keyword          string        `json:"keyword,omitempty" doc:"List by keyword"`
+	ListAll          *bool         `json:"listall,omitempty" doc:"If set to false, list only resources belonging to the command's caller; if set to true - list resources that the caller is authorized to see. Default value is false"`
+	Name             string        `json:"name,omitempty" doc:"the name of the load balancer rule"`
+	NetworkID        string        `json:"networkid,omitempty" doc:"list by network ID the rule belongs to"`
+	Page             int           `json:"page,omitempty"`
+	PageSize         int           `json:"pagesize,omitempty"`
+	PublicIPID       string        `json:"publicipid,omitempty" doc:"the public IP address ID of the load balancer rule"`
+	Tags             []ResourceTag `json:"tags,omitempty" doc:"List resources by tags (key/value pairs)"`
+	VirtualMachineID string        `json:"virtualmachineid,omitempty" doc:"the ID of the virtual machine of the load balancer rule"`
+	ZoneID           string        `json:"zoneid,omitempty" doc:"the availability zone ID"`
+}
+
+// ListLoadBalancerRulesResponse represents a list of load balancer rules
+type ListLoadBalancerRulesResponse struct {
+	Count            int                `json:"count"`
+	LoadBalancerRule []LoadBalancerRule `json:"loadbalancerrule"`
+}
+
+// AssignToLoadBalancerRule (Async) represents the assignment of virtual machines to a load balancer rule
+//
+// CloudStack API: https://cloudstack.apache.org/api/apidocs-4.10/apis/assignToLoadBalancerRule.html
+type AssignToLoadBalancerRule struct {
+	ID                string   `json:"id" doc:"the ID of the load balancer rule"`
+	VirtualMachineIDs []string `json:"virtualmachineids,omitempty" doc:"the list of IDs of the virtual machine that are being assigned to the load balancer rule(i.e. virtualMachineIds=1,2,3)"`
+}
+
+// RemoveFromLoadBalancerRule (Async) represents the removal of virtual machines from a load balancer rule
+//
+// CloudStack API: https://cloudstack.apache.org/api/apidocs-4.10/apis/removeFromLoadBalancerRule.html
+type RemoveFromLoadBalancerRule struct {
+	ID                string   `json:"id" doc:"The ID of the load balancer rule"`
+	VirtualMachineIDs []string `json:"virtualmachineids,omitempty" doc:"the list of IDs of the virtual machines that are being removed from the load balancer rule (i.e. virtualMachineIds=1,2,3)"`
+}
+
+// ListLoadBalancerRuleInstances represents a search for the virtual machines of a load balancer rule
+//
+// CloudStack API: https://cloudstack.apache.org/api/apidocs-4.10/apis/listLoadBalancerRuleInstances.html
+type ListLoadBalancerRuleInstances struct {
+	ID       string `json:"id" doc:"the ID of the load balancer rule"`
+	Applied  *bool  `json:"applied,omitempty" doc:"true if listing all virtual machines currently applied to the load balancer rule; default is true"`
+	Keyword  string `json:"keyword,omitempty" doc:"List by keyword"`
+	Page     int    `json:"page,omitempty"`
+	PageSize int    `json:"pagesize,omitempty"`
+}
+
+// ListLoadBalancerRuleInstancesResponse represents a list of the virtual machines of a load balancer rule
+type ListLoadBalancerRuleInstancesResponse struct {
+	Count                    int              `json:"count"`
+	LoadBalancerRuleInstance []VirtualMachine `json:"loadbalancerruleinstance"`
+}
+
+// CreateLBHealthCheckPolicy (Async) represents the creation of a health check policy on a load balancer rule
+//
+// CloudStack API: https://cloudstack.apache.org/api/apidocs-4.10/apis/createLBHealthCheckPolicy.html
+type CreateLBHealthCheckPolicy struct {
+	LBRuleID           string `json:"lbruleid" doc:"the ID of the load balancer rule"`
+	Description        string `json:"description,omitempty" doc:"the description of the load balancer health check policy"`
+	ForDisplay         *bool  `json:"fordisplay,omitempty" doc:"an optional field, whether to the display the rule to the end user or not"`
+	HealthyThreshold   int    `json:"healthythreshold,omitempty" doc:"Number of consecutive health check success before declaring an instance healthy"`
+	IntervalTime       int    `json:"intervaltime,omitempty" doc:"Amount of time between health checks (1 sec - 20940 sec)"`
+	PingPath           string `json:"pingpath,omitempty" doc:"HTTP ping path"`
+	ResponseTimeout    int    `json:"responsetimeout,omitempty" doc:"Time to wait when receiving a response from the health check (2sec - 60 sec)"`
+	UnhealthyThreshold int    `json:"unhealthythreshold,omitempty" doc:"Number of consecutive health check failures before declaring an instance unhealthy"`
+}
+
+// DeleteLBHealthCheckPolicy (Async) represents the deletion of a health check policy
+//
+// CloudStack API: https://cloudstack.apache.org/api/apidocs-4.10/apis/deleteLBHealthCheckPolicy.html
+type DeleteLBHealthCheckPolicy struct {
+	ID string `json:"id" doc:"the ID of the load balancer health check policy"`
+}
+
+// ListLBHealthCheckPolicies represents a search for the health check policies of a load balancer rule
+//
+// CloudStack API: https://cloudstack.apache.org/api/apidocs-4.10/apis/listLBHealthCheckPolicies.html
+type ListLBHealthCheckPolicies struct {
+	ForDisplay *bool  `json:"fordisplay,omitempty" doc:"list resources by display flag; only ROOT admin is eligible to pass this parameter"`
+	ID         string `json:"id,omitempty" doc:"the ID of the health check policy"`
+	Keyword    string `json:"keyword,omitempty" doc:"List by keyword"`
+	LBRuleID   string `json:"lbruleid,omitempty" doc:"the ID of the load balancer rule"`
+	Page       int    `json:"page,omitempty"`
+	PageSize   int    `json:"pagesize,omitempty"`
+}
+
+// ListLBHealthCheckPoliciesResponse represents a list of health check policies
+type ListLBHealthCheckPoliciesResponse struct {
+	Count               int             `json:"count"`
+	HealthCheckPolicies []LBHealthCheck `json:"healthcheckpolicies"`
+}
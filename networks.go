@@ -1,13 +1,13 @@
 package egoscale
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 )
 
 // ListRequest builds the ListNetworks request
 func (network *Network) ListRequest() (ListCommand, error) {
-	//TODO add tags support
 	req := &ListNetworks{
 		Account:           network.Account,
 		ACLType:           network.ACLType,
@@ -16,6 +16,7 @@ func (network *Network) ListRequest() (ListCommand, error) {
 		ID:                network.ID,
 		PhysicalNetworkID: network.PhysicalNetworkID,
 		RestartRequired:   &network.RestartRequired,
+		Tags:              tagFilter(network.Tags),
 		TrafficType:       network.TrafficType,
 		Type:              network.Type,
 		ZoneID:            network.ZoneID,
@@ -29,6 +30,22 @@ func (*Network) ResourceType() string {
 	return "Network"
 }
 
+// ResourceID returns the identifier of the resource
+func (network *Network) ResourceID() string {
+	return network.ID
+}
+
+// Delete removes the given network
+func (network *Network) Delete(ctx context.Context, client *Client) error {
+	if network.ID == "" {
+		return fmt.Errorf("a Network may only be deleted using ID")
+	}
+
+	return client.BooleanRequestWithContext(ctx, &DeleteNetwork{
+		ID: network.ID,
+	})
+}
+
 func (*CreateNetwork) name() string {
 	return "createNetwork"
 }
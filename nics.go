@@ -1,9 +1,24 @@
 package egoscale
 
 import (
+	"context"
 	"errors"
+	"fmt"
 )
 
+// Delete removes the given nic from its virtual machine
+func (nic *Nic) Delete(ctx context.Context, client *Client) error {
+	if nic.ID == "" || nic.VirtualMachineID == "" {
+		return fmt.Errorf("a Nic may only be deleted using ID and VirtualMachineID")
+	}
+
+	_, err := client.RequestWithContext(ctx, &RemoveNicFromVirtualMachine{
+		NicID:            nic.ID,
+		VirtualMachineID: nic.VirtualMachineID,
+	})
+	return err
+}
+
 // ListRequest build a ListNics request from the given Nic
 func (nic *Nic) ListRequest() (ListCommand, error) {
 	if nic.VirtualMachineID == "" {
@@ -0,0 +1,123 @@
+package egoscale
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// ListRequest builds the ListOsTypes request
+func (ot *OSType) ListRequest() (ListCommand, error) {
+	req := &ListOsTypes{
+		Description:  ot.Description,
+		ID:           ot.ID,
+		OSCategoryID: ot.OSCategoryID,
+	}
+
+	return req, nil
+}
+
+// ListRequest builds the ListOsCategories request
+func (oc *OSCategory) ListRequest() (ListCommand, error) {
+	req := &ListOsCategories{
+		ID:   oc.ID,
+		Name: oc.Name,
+	}
+
+	return req, nil
+}
+
+// OSTypeByDescription resolves the OS type from its exact description, e.g. "Debian GNU/Linux 9 (64-bit)"
+//
+// CloudStack filters the descriptions by their content, which may return the
+// 32-bit and 64-bit flavors of the same system, hence the exact match.
+func (client *Client) OSTypeByDescription(ctx context.Context, description string) (*OSType, error) {
+	ots, err := client.ListWithContext(ctx, &OSType{Description: description})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range ots {
+		ot := item.(*OSType)
+		if strings.EqualFold(ot.Description, description) {
+			return ot, nil
+		}
+	}
+
+	return nil, &ErrorResponse{
+		ErrorCode: ParamError,
+		ErrorText: fmt.Sprintf("not found, no OS type described as %q", description),
+	}
+}
+
+func (*ListOsTypes) name() string {
+	return "listOsTypes"
+}
+
+func (*ListOsTypes) description() string {
+	return "Lists all supported OS types for this cloud."
+}
+
+func (*ListOsTypes) response() interface{} {
+	return new(ListOsTypesResponse)
+}
+
+// SetPage sets the current page
+func (ls *ListOsTypes) SetPage(page int) {
+	ls.Page = page
+}
+
+// SetPageSize sets the page size
+func (ls *ListOsTypes) SetPageSize(pageSize int) {
+	ls.PageSize = pageSize
+}
+
+func (*ListOsTypes) each(resp interface{}, callback IterateItemFunc) {
+	ots, ok := resp.(*ListOsTypesResponse)
+	if !ok {
+		callback(nil, fmt.Errorf("wrong type. ListOsTypesResponse expected, got %T", resp))
+		return
+	}
+
+	for i := range ots.OSType {
+		if !callback(&ots.OSType[i], nil) {
+			break
+		}
+	}
+}
+
+func (*ListOsCategories) name() string {
+	return "listOsCategories"
+}
+
+func (*ListOsCategories) description() string {
+	return "Lists all supported OS categories for this cloud."
+}
+
+func (*ListOsCategories) response() interface{} {
+	return new(ListOsCategoriesResponse)
+}
+
+// SetPage sets the current page
+func (ls *ListOsCategories) SetPage(page int) {
+	ls.Page = page
+}
+
+// SetPageSize sets the page size
+func (ls *ListOsCategories) SetPageSize(pageSize int) {
+	ls.PageSize = pageSize
+}
+
+func (*ListOsCategories) each(resp interface{}, callback IterateItemFunc) {
+	ocs, ok := resp.(*ListOsCategoriesResponse)
+	if !ok {
+		callback(nil, fmt.Errorf("wrong type. ListOsCategoriesResponse expected, got %T", resp))
+		return
+	}
+
+	for i := range ocs.OSCategory {
+		if !callback(&ocs.OSCategory[i], nil) {
+			break
+		}
+	}
+}
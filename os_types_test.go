@@ -0,0 +1,85 @@
+package egoscale
+
+import (
+	"context"
+	"testing"
+)
+
+func TestListOsTypes(t *testing.T) {
+	req := &ListOsTypes{}
+	if req.name() != "listOsTypes" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.response().(*ListOsTypesResponse)
+}
+
+func TestListOsCategories(t *testing.T) {
+	req := &ListOsCategories{}
+	if req.name() != "listOsCategories" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.response().(*ListOsCategoriesResponse)
+}
+
+func TestGetOSCategory(t *testing.T) {
+	ts := newServer(response{200, jsonContentType, `
+{"listoscategoriesresponse": {
+	"count": 1,
+	"oscategory": [
+		{"id": "0b7a3d4f-1e6c-4ad1-8f52-b1ff9e0c1d2a", "name": "Debian"}
+	]
+}}`})
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+
+	oc := &OSCategory{Name: "Debian"}
+	if err := cs.Get(oc); err != nil {
+		t.Fatal(err)
+	}
+
+	if oc.ID != "0b7a3d4f-1e6c-4ad1-8f52-b1ff9e0c1d2a" {
+		t.Errorf("bad OS category, got %#v", oc)
+	}
+}
+
+func TestOSTypeByDescription(t *testing.T) {
+	body := `
+{"listostypesresponse": {
+	"count": 2,
+	"ostype": [
+		{
+			"description": "Debian GNU/Linux 9 (32-bit)",
+			"id": "a5e0e4c4-8d3b-4ac8-a8a1-6f0d7c3b2e11",
+			"isuserdefined": false,
+			"oscategoryid": "0b7a3d4f-1e6c-4ad1-8f52-b1ff9e0c1d2a"
+		},
+		{
+			"description": "Debian GNU/Linux 9 (64-bit)",
+			"id": "f2d6b1a4-0e1c-4b8e-9a6d-3c4b5a6d7e8f",
+			"isuserdefined": false,
+			"oscategoryid": "0b7a3d4f-1e6c-4ad1-8f52-b1ff9e0c1d2a"
+		}
+	]
+}}`
+	ts := newServer(
+		response{200, jsonContentType, body},
+		response{200, jsonContentType, body},
+	)
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+
+	ot, err := cs.OSTypeByDescription(context.Background(), "Debian GNU/Linux 9 (64-bit)")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ot.ID != "f2d6b1a4-0e1c-4b8e-9a6d-3c4b5a6d7e8f" {
+		t.Errorf("bad OS type, got %#v", ot)
+	}
+
+	if _, err := cs.OSTypeByDescription(context.Background(), "Debian GNU/Linux 9"); err == nil {
+		t.Error("an error was expected")
+	}
+}
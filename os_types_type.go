@@ -0,0 +1,50 @@
+package egoscale
+
+// OSType represents an operating system, as expected by the templates
+type OSType struct {
+	Description   string `json:"description,omitempty" doc:"the name/description of the OS type"`
+	ID            string `json:"id,omitempty" doc:"the ID of the OS type"`
+	OSCategoryID  string `json:"oscategoryid,omitempty" doc:"the ID of the OS category"`
+	IsUserDefined bool   `json:"isuserdefined,omitempty" doc:"is the guest OS user defined"`
+}
+
+// OSCategory represents a family of operating systems
+type OSCategory struct {
+	ID   string `json:"id,omitempty" doc:"the ID of the OS category"`
+	Name string `json:"name,omitempty" doc:"the name of the OS category"`
+}
+
+// ListOsTypes lists all supported OS types for this cloud
+//
+// CloudStack API: https://cloudstack.apache.org/api/apidocs-4.10/apis/listOsTypes.html
+type ListOsTypes struct {
+	Description  string `json:"description,omitempty" doc:"list os by description"`
+	ID           string `json:"id,omitempty" doc:"list by Os type Id"`
+	Keyword      string `json:"keyword,omitempty" doc:"List by keyword"`
+	OSCategoryID string `json:"oscategoryid,omitempty" doc:"list by Os Category id"`
+	Page         int    `json:"page,omitempty"`
+	PageSize     int    `json:"pagesize,omitempty"`
+}
+
+// ListOsTypesResponse represents a list of OS types
+type ListOsTypesResponse struct {
+	Count  int      `json:"count"`
+	OSType []OSType `json:"ostype"`
+}
+
+// ListOsCategories lists all supported OS categories for this cloud
+//
+// CloudStack API: https://cloudstack.apache.org/api/apidocs-4.10/apis/listOsCategories.html
+type ListOsCategories struct {
+	ID       string `json:"id,omitempty" doc:"list Os category by id"`
+	Keyword  string `json:"keyword,omitempty" doc:"List by keyword"`
+	Name     string `json:"name,omitempty" doc:"list os category by name"`
+	Page     int    `json:"page,omitempty"`
+	PageSize int    `json:"pagesize,omitempty"`
+}
+
+// ListOsCategoriesResponse represents a list of OS categories
+type ListOsCategoriesResponse struct {
+	Count      int          `json:"count"`
+	OSCategory []OSCategory `json:"oscategory"`
+}
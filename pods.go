@@ -0,0 +1,39 @@
+package egoscale
+
+import "fmt"
+
+func (*ListPods) name() string {
+	return "listPods"
+}
+
+func (*ListPods) description() string {
+	return "Lists all Pods."
+}
+
+func (*ListPods) response() interface{} {
+	return new(ListPodsResponse)
+}
+
+// SetPage sets the current page
+func (ls *ListPods) SetPage(page int) {
+	ls.Page = page
+}
+
+// SetPageSize sets the page size
+func (ls *ListPods) SetPageSize(pageSize int) {
+	ls.PageSize = pageSize
+}
+
+func (*ListPods) each(resp interface{}, callback IterateItemFunc) {
+	pods, ok := resp.(*ListPodsResponse)
+	if !ok {
+		callback(nil, fmt.Errorf("wrong type. ListPodsResponse expected, got %T", resp))
+		return
+	}
+
+	for i := range pods.Pod {
+		if !callback(&pods.Pod[i], nil) {
+			break
+		}
+	}
+}
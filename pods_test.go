@@ -0,0 +1,13 @@
+package egoscale
+
+import (
+	"testing"
+)
+
+func TestListPods(t *testing.T) {
+	req := &ListPods{}
+	if req.name() != "listPods" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.response().(*ListPodsResponse)
+}
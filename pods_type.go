@@ -0,0 +1,35 @@
+package egoscale
+
+// Pod represents a rack of hosts within a zone
+type Pod struct {
+	AllocationState string     `json:"allocationstate,omitempty" doc:"the allocation state of the Pod"`
+	Capacity        []Capacity `json:"capacity,omitempty" doc:"the capacity of the Pod"`
+	EndIP           string     `json:"endip,omitempty" doc:"the ending IP for the Pod"`
+	Gateway         string     `json:"gateway,omitempty" doc:"the gateway of the Pod"`
+	ID              string     `json:"id,omitempty" doc:"the ID of the Pod"`
+	Name            string     `json:"name,omitempty" doc:"the name of the Pod"`
+	Netmask         string     `json:"netmask,omitempty" doc:"the netmask of the Pod"`
+	StartIP         string     `json:"startip,omitempty" doc:"the starting IP for the Pod"`
+	ZoneID          string     `json:"zoneid,omitempty" doc:"the Zone ID of the Pod"`
+	ZoneName        string     `json:"zonename,omitempty" doc:"the Zone name of the Pod"`
+}
+
+// ListPods represents a query to list the pods
+//
+// CloudStack API: https://cloudstack.apache.org/api/apidocs-4.10/apis/listPods.html
+type ListPods struct {
+	AllocationState string `json:"allocationstate,omitempty" doc:"list pods by allocation state"`
+	ID              string `json:"id,omitempty" doc:"list Pods by ID"`
+	Keyword         string `json:"keyword,omitempty" doc:"List by keyword"`
+	Name            string `json:"name,omitempty" doc:"list Pods by name"`
+	Page            int    `json:"page,omitempty"`
+	PageSize        int    `json:"pagesize,omitempty"`
+	ShowCapacities  *bool  `json:"showcapacities,omitempty" doc:"flag to display the capacity of the pods"`
+	ZoneID          string `json:"zoneid,omitempty" doc:"list Pods by Zone ID"`
+}
+
+// ListPodsResponse represents a list of pods
+type ListPodsResponse struct {
+	Count int   `json:"count"`
+	Pod   []Pod `json:"pod"`
+}
@@ -0,0 +1,99 @@
+package egoscale
+
+import (
+	"context"
+	"fmt"
+)
+
+// ResourceType returns the type of the resource
+func (*PortForwardingRule) ResourceType() string {
+	return "PortForwardingRule"
+}
+
+// ResourceID returns the identifier of the resource
+func (rule *PortForwardingRule) ResourceID() string {
+	return rule.ID
+}
+
+// Delete removes the given port forwarding rule
+func (rule *PortForwardingRule) Delete(ctx context.Context, client *Client) error {
+	if rule.ID == "" {
+		return fmt.Errorf("a PortForwardingRule may only be deleted using ID")
+	}
+
+	return client.BooleanRequestWithContext(ctx, &DeletePortForwardingRule{
+		ID: rule.ID,
+	})
+}
+
+// ListRequest builds the ListPortForwardingRules request
+func (rule *PortForwardingRule) ListRequest() (ListCommand, error) {
+	req := &ListPortForwardingRules{
+		ID:          rule.ID,
+		IPAddressID: rule.IPAddressID,
+		NetworkID:   rule.NetworkID,
+		Tags:        tagFilter(rule.Tags),
+	}
+
+	return req, nil
+}
+
+func (*CreatePortForwardingRule) name() string {
+	return "createPortForwardingRule"
+}
+
+func (*CreatePortForwardingRule) description() string {
+	return "Creates a port forwarding rule"
+}
+
+func (*CreatePortForwardingRule) asyncResponse() interface{} {
+	return new(PortForwardingRule)
+}
+
+func (*ListPortForwardingRules) name() string {
+	return "listPortForwardingRules"
+}
+
+func (*ListPortForwardingRules) description() string {
+	return "Lists all port forwarding rules for an IP address."
+}
+
+func (*ListPortForwardingRules) response() interface{} {
+	return new(ListPortForwardingRulesResponse)
+}
+
+// SetPage sets the current page
+func (ls *ListPortForwardingRules) SetPage(page int) {
+	ls.Page = page
+}
+
+// SetPageSize sets the page size
+func (ls *ListPortForwardingRules) SetPageSize(pageSize int) {
+	ls.PageSize = pageSize
+}
+
+func (*ListPortForwardingRules) each(resp interface{}, callback IterateItemFunc) {
+	rules, ok := resp.(*ListPortForwardingRulesResponse)
+	if !ok {
+		callback(nil, fmt.Errorf("wrong type. ListPortForwardingRulesResponse expected, got %T", resp))
+		return
+	}
+
+	for i := range rules.PortForwardingRule {
+		if !callback(&rules.PortForwardingRule[i], nil) {
+			break
+		}
+	}
+}
+
+func (*DeletePortForwardingRule) name() string {
+	return "deletePortForwardingRule"
+}
+
+func (*DeletePortForwardingRule) description() string {
+	return "Deletes a port forwarding rule"
+}
+
+func (*DeletePortForwardingRule) asyncResponse() interface{} {
+	return new(booleanResponse)
+}
@@ -0,0 +1,85 @@
+package egoscale
+
+import (
+	"testing"
+)
+
+func TestPortForwardingRule(t *testing.T) {
+	instance := &PortForwardingRule{}
+	if instance.ResourceType() != "PortForwardingRule" {
+		t.Errorf("ResourceType doesn't match")
+	}
+}
+
+func TestCreatePortForwardingRule(t *testing.T) {
+	req := &CreatePortForwardingRule{}
+	if req.name() != "createPortForwardingRule" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.asyncResponse().(*PortForwardingRule)
+}
+
+func TestListPortForwardingRules(t *testing.T) {
+	req := &ListPortForwardingRules{}
+	if req.name() != "listPortForwardingRules" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.response().(*ListPortForwardingRulesResponse)
+}
+
+func TestDeletePortForwardingRule(t *testing.T) {
+	req := &DeletePortForwardingRule{}
+	if req.name() != "deletePortForwardingRule" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.asyncResponse().(*booleanResponse)
+}
+
+func TestListPortForwardingRulesOfIPAddress(t *testing.T) {
+	ts := newServer(response{200, jsonContentType, `
+{"listportforwardingrulesresponse": {
+	"count": 1,
+	"portforwardingrule": [
+		{
+			"cidrlist": "0.0.0.0/0",
+			"fordisplay": true,
+			"id": "5b2c6f0e-1d3a-4f5b-8c7d-9e0f1a2b3c4d",
+			"ipaddress": "159.100.240.12",
+			"ipaddressid": "a0f2c7e1-3b5d-4c6e-8f9a-0b1c2d3e4f5a",
+			"networkid": "00304a04-c7ea-4e77-a786-18bc64347bf7",
+			"privateendport": "8080",
+			"privateport": "8080",
+			"protocol": "tcp",
+			"publicendport": "80",
+			"publicport": "80",
+			"state": "Active",
+			"tags": [],
+			"virtualmachinedisplayname": "web",
+			"virtualmachineid": "9ccc3d5b-9dce-4302-a955-24b80b402f88",
+			"virtualmachinename": "web",
+			"vmguestip": "10.1.1.12"
+		}
+	]
+}}`})
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+
+	rules, err := cs.List(&PortForwardingRule{IPAddressID: "a0f2c7e1-3b5d-4c6e-8f9a-0b1c2d3e4f5a"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rules) != 1 {
+		t.Fatalf("one rule was expected, got %d", len(rules))
+	}
+
+	rule := rules[0].(*PortForwardingRule)
+	if rule.PublicPort != 80 || rule.PrivatePort != 8080 {
+		t.Errorf("bad ports, got %d -> %d", rule.PublicPort, rule.PrivatePort)
+	}
+
+	if rule.VMGuestIP.String() != "10.1.1.12" {
+		t.Errorf("bad guest IP, got %s", rule.VMGuestIP)
+	}
+}
@@ -0,0 +1,75 @@
+package egoscale
+
+import (
+	"net"
+)
+
+// PortForwardingRule represents a forwarding of a public port to a virtual machine port
+type PortForwardingRule struct {
+	CidrList                  string        `json:"cidrlist,omitempty" doc:"the cidr list to forward traffic from, comma separated"`
+	ForDisplay                bool          `json:"fordisplay,omitempty" doc:"is firewall for display to the regular user"`
+	ID                        string        `json:"id,omitempty" doc:"the ID of the port forwarding rule"`
+	IPAddress                 net.IP        `json:"ipaddress,omitempty" doc:"the public ip address for the port forwarding rule"`
+	IPAddressID               string        `json:"ipaddressid,omitempty" doc:"the public ip address id for the port forwarding rule"`
+	NetworkID                 string        `json:"networkid,omitempty" doc:"the id of the guest network the port forwarding rule belongs to"`
+	PrivateEndPort            uint16        `json:"privateendport,omitempty,string" doc:"the ending port of port forwarding rule's private port range"`
+	PrivatePort               uint16        `json:"privateport,omitempty,string" doc:"the starting port of port forwarding rule's private port range"`
+	Protocol                  string        `json:"protocol,omitempty" doc:"the protocol of the port forwarding rule"`
+	PublicEndPort             uint16        `json:"publicendport,omitempty,string" doc:"the ending port of port forwarding rule's private port range"`
+	PublicPort                uint16        `json:"publicport,omitempty,string" doc:"the starting port of port forwarding rule's public port range"`
+	State                     string        `json:"state,omitempty" doc:"the state of the rule"`
+	Tags                      []ResourceTag `json:"tags,omitempty" doc:"the list of resource tags associated with the rule"`
+	VirtualMachineDisplayName string        `json:"virtualmachinedisplayname,omitempty" doc:"the VM display name for the port forwarding rule"`
+	VirtualMachineID          string        `json:"virtualmachineid,omitempty" doc:"the VM ID for the port forwarding rule"`
+	VirtualMachineName        string        `json:"virtualmachinename,omitempty" doc:"the VM name for the port forwarding rule"`
+	VMGuestIP                 net.IP        `json:"vmguestip,omitempty" doc:"the vm ip address for the port forwarding rule"`
+}
+
+// CreatePortForwardingRule (Async) represents the creation of a port forwarding rule
+//
+// CloudStack API: https://cloudstack.apache.org/api/apidocs-4.10/apis/createPortForwardingRule.html
+type CreatePortForwardingRule struct {
+	IPAddressID      string   `json:"ipaddressid" doc:"the IP address id of the port forwarding rule"`
+	PrivatePort      uint16   `json:"privateport" doc:"the starting port of port forwarding rule's private port range"`
+	Protocol         string   `json:"protocol" enum:"tcp,udp" doc:"the protocol for the port forwarding rule. Valid values are TCP or UDP."`
+	PublicPort       uint16   `json:"publicport" doc:"the starting port of port forwarding rule's public port range"`
+	VirtualMachineID string   `json:"virtualmachineid" doc:"the ID of the virtual machine for the port forwarding rule"`
+	CidrList         []string `json:"cidrlist,omitempty" doc:"the cidr list to forward traffic from"`
+	ForDisplay       *bool    `json:"fordisplay,omitempty" doc:"an optional field, whether to the display the rule to the end user or not"`
+	NetworkID        string   `json:"networkid,omitempty" doc:"the network of the virtual machine the port forwarding rule will be created for. Required when public IP address is not associated with any guest network yet (VPC case)."`
+	OpenFirewall     *bool    `json:"openfirewall,omitempty" doc:"if true, firewall rule for source/end public port is automatically created; if false - firewall rule has to be created explicitly. If not specified 1) defaulted to false when PF rule is being created for VPC guest network 2) in all other cases defaulted to true"`
+	PrivateEndPort   uint16   `json:"privateendport,omitempty" doc:"the ending port of port forwarding rule's private port range"`
+	PublicEndPort    uint16   `json:"publicendport,omitempty" doc:"the ending port of port forwarding rule's private port range"`
+	VMGuestIP        net.IP   `json:"vmguestip,omitempty" doc:"VM guest nic secondary IP address for the port forwarding rule"`
+}
+
+// ListPortForwardingRules represents a search for the port forwarding rules
+//
+// CloudStack API: https://cloudstack.apache.org/api/apidocs-4.10/apis/listPortForwardingRules.html
+type ListPortForwardingRules struct {
+	Account     string        `json:"account,omitempty" doc:"list resources by account. Must be used with the domainId parameter."`
+	DomainID    string        `json:"domainid,omitempty" doc:"list only resources belonging to the domain specified"`
+	ForDisplay  *bool         `json:"fordisplay,omitempty" doc:"list resources by display flag; only ROOT admin is eligible to pass this parameter"`
+	ID          string        `json:"id,omitempty" doc:"Lists rule with the specified ID."`
+	IPAddressID string        `json:"ipaddressid,omitempty" doc:"the ID of IP address of the port forwarding services"`
+	IsRecursive *bool         `json:"isrecursive,omitempty" doc:"defaults to false, but if true, lists all resources from the parent specified by the domainId till leaves."`
+	Keyword     string        `json:"keyword,omitempty" doc:"List by keyword"`
+	ListAll     *bool         `json:"listall,omitempty" doc:"If set to false, list only resources belonging to the command's caller; if set to true - list resources that the caller is authorized to see. Default value is false"`
+	NetworkID   string        `json:"networkid,omitempty" doc:"list port forwarding rules for certain network"`
+	Page        int           `json:"page,omitempty"`
+	PageSize    int           `json:"pagesize,omitempty"`
+	Tags        []ResourceTag `json:"tags,omitempty" doc:"List resources by tags (key/value pairs)"`
+}
+
+// ListPortForwardingRulesResponse represents a list of port forwarding rules
+type ListPortForwardingRulesResponse struct {
+	Count              int                  `json:"count"`
+	PortForwardingRule []PortForwardingRule `json:"portforwardingrule"`
+}
+
+// DeletePortForwardingRule (Async) represents the deletion of a port forwarding rule
+//
+// CloudStack API: https://cloudstack.apache.org/api/apidocs-4.10/apis/deletePortForwardingRule.html
+type DeletePortForwardingRule struct {
+	ID string `json:"id" doc:"the ID of the port forwarding rule"`
+}
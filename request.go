@@ -13,9 +13,11 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"reflect"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -56,64 +58,249 @@ func (e *booleanResponse) Error() error {
 	return fmt.Errorf("API error: %s", e.DisplayText)
 }
 
-func (client *Client) parseResponse(resp *http.Response, key string) (json.RawMessage, error) {
+// parseResponse finds the response envelope and decodes its content into v
+//
+// The body is streamed, only the first value of the envelope is buffered, which
+// is usually the count of a list response. The error fields found in the
+// envelope (CloudStack may answer an error with a 200) are kept in errResponse.
+func (client *Client) parseResponse(resp *http.Response, key string, v interface{}, errResponse *ErrorResponse) error {
 	contentType := resp.Header.Get("content-type")
 
 	if !strings.Contains(contentType, "application/json") {
-		return nil, fmt.Errorf("body content-type response expected \"application/json\", got %q", contentType)
+		return fmt.Errorf("body content-type response expected \"application/json\", got %q", contentType)
 	}
 
+	if resp.StatusCode >= 400 {
+		return parseErrorResponse(resp, key)
+	}
+
+	dec := json.NewDecoder(resp.Body)
+	if err := expectDelim(dec, '{'); err != nil {
+		return err
+	}
+
+	first := ""
+	for dec.More() {
+		k, err := decodeKey(dec)
+		if err != nil {
+			return err
+		}
+
+		if k == key {
+			if !isStreamable(v) {
+				var envelope json.RawMessage
+				if err := dec.Decode(&envelope); err != nil {
+					return err
+				}
+				return unmarshalEnvelope(envelope, v, errResponse)
+			}
+			return decodeEnvelope(dec, v, errResponse)
+		}
+
+		if first == "" {
+			first = k
+		}
+		var skip json.RawMessage
+		if err := dec.Decode(&skip); err != nil {
+			return err
+		}
+	}
+
+	return fmt.Errorf("malformed JSON response, %q was expected, got %q", key, first)
+}
+
+// parseErrorResponse reads the CloudStack error
+func parseErrorResponse(resp *http.Response, key string) error {
 	b, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	m := map[string]json.RawMessage{}
 	if err := json.Unmarshal(b, &m); err != nil {
-		return nil, err
+		return err
 	}
 
 	response, ok := m[key]
 	if !ok {
-		if resp.StatusCode >= 400 {
-			response, ok = m["errorresponse"]
-		}
+		response, ok = m["errorresponse"]
 		if !ok {
 			for k := range m {
-				return nil, fmt.Errorf("malformed JSON response, %q was expected, got %q", key, k)
+				return fmt.Errorf("malformed JSON response, %q was expected, got %q", key, k)
 			}
 		}
 	}
 
-	if resp.StatusCode >= 400 {
-		errorResponse := new(ErrorResponse)
-		if e := json.Unmarshal(response, errorResponse); e != nil && errorResponse.ErrorCode <= 0 {
-			return nil, fmt.Errorf("%d %s", resp.StatusCode, b)
+	errorResponse := new(ErrorResponse)
+	if e := json.Unmarshal(response, errorResponse); e != nil && errorResponse.ErrorCode <= 0 {
+		return fmt.Errorf("%d %s", resp.StatusCode, b)
+	}
+	return errorResponse
+}
+
+// decodeEnvelope decodes the content of the response envelope into v
+//
+// An envelope containing only one key is unwrapped, unless it's a boolean response
+// or an async job, otherwise its keys are decoded one by one into v. The keys
+// unknown to v go into errResponse, if they belong to it.
+func decodeEnvelope(dec *json.Decoder, v interface{}, errResponse *ErrorResponse) error {
+	if err := expectDelim(dec, '{'); err != nil {
+		return err
+	}
+
+	if !dec.More() {
+		return expectDelim(dec, '}')
+	}
+
+	k, err := decodeKey(dec)
+	if err != nil {
+		return err
+	}
+
+	var first json.RawMessage
+	if err := dec.Decode(&first); err != nil {
+		return err
+	}
+
+	unmarshalFirst := func(field interface{}) error {
+		return json.Unmarshal(first, field)
+	}
+
+	if !dec.More() {
+		if err := expectDelim(dec, '}'); err != nil {
+			return err
+		}
+
+		// boolean response and asyncjob result may also contain
+		// only one key
+		if k == "success" || k == "jobid" {
+			return decodeField(v, k, unmarshalFirst, errResponse)
 		}
-		return nil, errorResponse
+		return json.Unmarshal(first, v)
 	}
 
-	n := map[string]json.RawMessage{}
-	if err := json.Unmarshal(response, &n); err != nil {
-		return nil, err
+	if err := decodeField(v, k, unmarshalFirst, errResponse); err != nil {
+		return err
+	}
+
+	for dec.More() {
+		k, err := decodeKey(dec)
+		if err != nil {
+			return err
+		}
+		if err := decodeField(v, k, dec.Decode, errResponse); err != nil {
+			return err
+		}
 	}
 
-	if len(n) > 1 {
-		return response, nil
+	return expectDelim(dec, '}')
+}
+
+// unmarshalEnvelope decodes the buffered envelope into v, like decodeEnvelope does
+func unmarshalEnvelope(envelope json.RawMessage, v interface{}, errResponse *ErrorResponse) error {
+	n := map[string]json.RawMessage{}
+	if err := json.Unmarshal(envelope, &n); err != nil {
+		return err
 	}
 
 	if len(n) == 1 {
 		for k := range n {
 			// boolean response and asyncjob result may also contain
 			// only one key
-			if k == "success" || k == "jobid" {
-				return response, nil
+			if k != "success" && k != "jobid" {
+				return json.Unmarshal(n[k], v)
 			}
-			return n[k], nil
 		}
 	}
 
-	return response, nil
+	json.Unmarshal(envelope, errResponse) // nolint: errcheck
+	return json.Unmarshal(envelope, v)
+}
+
+// decodeField decodes the value of the given key into the matching field of the struct v
+//
+// v is expected to be streamable, see isStreamable.
+func decodeField(v interface{}, key string, decode func(interface{}) error, errResponse *ErrorResponse) error {
+	value := reflect.ValueOf(v).Elem()
+
+	field, ok := structPlanOf(value.Type()).field(key)
+	if !ok {
+		if errResponse != nil {
+			if _, ok := structPlanOf(reflect.TypeOf(errResponse).Elem()).field(key); ok {
+				return decodeField(errResponse, key, decode, nil)
+			}
+		}
+
+		var skip json.RawMessage
+		return decode(&skip)
+	}
+
+	return decode(value.Field(field.index).Addr().Interface())
+}
+
+// streamables caches whether a type may be decoded field by field
+var streamables sync.Map
+
+// isStreamable tells whether v is a pointer to a struct whose fields are all
+// matched by their JSON name, anything else is left to encoding/json
+func isStreamable(v interface{}) bool {
+	typeof := reflect.TypeOf(v)
+	if typeof == nil || typeof.Kind() != reflect.Ptr || typeof.Elem().Kind() != reflect.Struct {
+		return false
+	}
+	typeof = typeof.Elem()
+
+	if ok, found := streamables.Load(typeof); found {
+		return ok.(bool)
+	}
+
+	ok := true
+	for i := 0; i < typeof.NumField(); i++ {
+		field := typeof.Field(i)
+		if field.Anonymous {
+			ok = false
+			break
+		}
+
+		if field.PkgPath != "" {
+			continue
+		}
+
+		// untagged, ignored or ",string" fields
+		tag, found := field.Tag.Lookup("json")
+		if !found || strings.HasPrefix(tag, "-") || strings.Contains(tag, ",string") {
+			ok = false
+			break
+		}
+	}
+
+	streamables.Store(typeof, ok)
+	return ok
+}
+
+func decodeKey(dec *json.Decoder) (string, error) {
+	t, err := dec.Token()
+	if err != nil {
+		return "", err
+	}
+
+	k, ok := t.(string)
+	if !ok {
+		return "", fmt.Errorf("malformed JSON response, a key was expected, got %v", t)
+	}
+	return k, nil
+}
+
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	t, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	if d, ok := t.(json.Delim); !ok || d != delim {
+		return fmt.Errorf("malformed JSON response, %q was expected, got %v", delim, t)
+	}
+	return nil
 }
 
 // asyncRequest perform an asynchronous job with a context
@@ -139,30 +326,24 @@ func (client *Client) asyncRequest(ctx context.Context, request AsyncCommand) (i
 
 // syncRequest performs a sync request with a context
 func (client *Client) syncRequest(ctx context.Context, request syncCommand) (interface{}, error) {
-	body, err := client.request(ctx, request)
-	if err != nil {
-		return nil, err
-	}
-
 	response := request.response()
-	err = json.Unmarshal(body, response)
+	errResponse := new(ErrorResponse)
+	err := client.request(ctx, request, response, errResponse)
 
 	// booleanResponse will alway be valid...
 	if err == nil {
 		if br, ok := response.(*booleanResponse); ok {
 			success, e := br.IsSuccess()
 			if e != nil {
-				return nil, e
-			}
-			if !success {
+				err = e
+			} else if !success {
 				err = errors.New("not a valid booleanResponse")
 			}
 		}
 	}
 
 	if err != nil {
-		errResponse := new(ErrorResponse)
-		if e := json.Unmarshal(body, errResponse); e == nil && errResponse.ErrorCode > 0 {
+		if errResponse.ErrorCode > 0 {
 			return errResponse, nil
 		}
 		return nil, err
@@ -182,6 +363,11 @@ func (client *Client) BooleanRequest(req Command) error {
 		return b.Error()
 	}
 
+	// an error returned with a 200, see syncRequest
+	if e, ok := resp.(*ErrorResponse); ok {
+		return e
+	}
+
 	panic(fmt.Errorf("command %q is not a proper boolean response. %#v", req.name(), resp))
 }
 
@@ -196,6 +382,11 @@ func (client *Client) BooleanRequestWithContext(ctx context.Context, req Command
 		return b.Error()
 	}
 
+	// an error returned with a 200, see syncRequest
+	if e, ok := resp.(*ErrorResponse); ok {
+		return e
+	}
+
 	panic(fmt.Errorf("command %q is not a proper boolean response. %#v", req.name(), resp))
 }
 
@@ -235,23 +426,22 @@ func (client *Client) AsyncRequest(request AsyncCommand, callback WaitAsyncJobRe
 
 // AsyncRequestWithContext preforms a request with a context
 func (client *Client) AsyncRequestWithContext(ctx context.Context, request AsyncCommand, callback WaitAsyncJobResultFunc) {
-	body, err := client.request(ctx, request)
-	if err != nil {
-		callback(nil, err)
-		return
-	}
-
 	jobResult := new(AsyncJobResult)
-	if err := json.Unmarshal(body, jobResult); err != nil {
-		r := new(ErrorResponse)
-		if e := json.Unmarshal(body, r); e != nil && r.ErrorCode > 0 {
-			if !callback(nil, r) {
+	errResponse := new(ErrorResponse)
+	if err := client.request(ctx, request, jobResult, errResponse); err != nil {
+		if errResponse.ErrorCode > 0 {
+			if !callback(nil, errResponse) {
 				return
 			}
 		}
-		if !callback(nil, err) {
-			return
-		}
+		callback(nil, err)
+		return
+	}
+
+	// without a JobID, an error may have been returned with a 200
+	if jobResult.JobID == "" && errResponse.ErrorCode > 0 {
+		callback(nil, errResponse)
+		return
 	}
 
 	// Successful response
@@ -291,6 +481,12 @@ func (client *Client) AsyncRequestWithContext(ctx context.Context, request Async
 
 // Payload builds the HTTP request from the given command
 func (client *Client) Payload(request Command) (string, error) {
+	if client.Validator != nil {
+		if err := client.Validator.Validate(request); err != nil {
+			return "", err
+		}
+	}
+
 	params := url.Values{}
 	err := prepareValues("", &params, request)
 	if err != nil {
@@ -342,14 +538,16 @@ func (client *Client) Sign(query string) (string, error) {
 }
 
 // request makes a Request while being close to the metal
-func (client *Client) request(ctx context.Context, req Command) (json.RawMessage, error) {
+//
+// The response is decoded into v, the error fields found along into errResponse.
+func (client *Client) request(ctx context.Context, req Command, v interface{}, errResponse *ErrorResponse) error {
 	payload, err := client.Payload(req)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	query, err := client.Sign(payload)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	method := "GET"
@@ -365,7 +563,7 @@ func (client *Client) request(ctx context.Context, req Command) (json.RawMessage
 
 	request, err := http.NewRequest(method, url, body)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	request = request.WithContext(ctx)
 	request.Header.Add("User-Agent", fmt.Sprintf("exoscale/egoscale (%v)", Version))
@@ -377,7 +575,7 @@ func (client *Client) request(ctx context.Context, req Command) (json.RawMessage
 
 	resp, err := client.HTTPClient.Do(request)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	defer resp.Body.Close() // nolint: errcheck
 
@@ -387,10 +585,5 @@ func (client *Client) request(ctx context.Context, req Command) (json.RawMessage
 		key = "addiptovmnicresponse"
 	}
 
-	text, err := client.parseResponse(resp, key)
-	if err != nil {
-		return nil, err
-	}
-
-	return text, nil
+	return client.parseResponse(resp, key, v, errResponse)
 }
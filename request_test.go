@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"math/rand"
 	"net/http"
 	"net/http/httptest"
@@ -552,6 +553,172 @@ func TestWrongBodyResponse(t *testing.T) {
 	}
 }
 
+func TestRequestStreamedResponse(t *testing.T) {
+	ts := newServer(response{200, jsonContentType, `
+{
+	"somethingelse": {"foo": ["bar"]},
+	"listvirtualmachinesresponse": {
+		"virtualmachine": [
+			{"id": "1", "name": "foo"},
+			{"id": "2", "name": "bar"}
+		],
+		"unknown": {"foo": "bar"},
+		"count": 2
+	}
+}`}, response{200, jsonContentType, `
+{"getvmpasswordresponse": {
+	"password": {
+		"encryptedpassword": "abc"
+	}
+}}`})
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+
+	resp, err := cs.Request(&ListVirtualMachines{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	vms := resp.(*ListVirtualMachinesResponse)
+	if vms.Count != 2 || len(vms.VirtualMachine) != 2 {
+		t.Errorf("two virtual machines were expected, got %#v", vms)
+	}
+
+	if vms.VirtualMachine[1].Name != "bar" {
+		t.Errorf("bad name, got %q", vms.VirtualMachine[1].Name)
+	}
+
+	resp, err = cs.Request(&GetVMPassword{ID: "1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.(*Password).EncryptedPassword != "abc" {
+		t.Errorf("bad password, got %#v", resp)
+	}
+}
+
+func TestRequestMalformedResponse(t *testing.T) {
+	ts := newServer(response{200, jsonContentType, `
+{"listzonesresponse": []}
+`}, response{200, jsonContentType, `
+{"listvirtualmachinesresponse": {"count": 0}}
+`})
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+
+	if _, err := cs.Request(&ListZones{}); err == nil {
+		t.Error("an error was expected")
+	}
+
+	_, err := cs.Request(&ListZones{})
+	if err == nil {
+		t.Fatal("an error was expected")
+	}
+
+	if err.Error() != `malformed JSON response, "listzonesresponse" was expected, got "listvirtualmachinesresponse"` {
+		t.Errorf("bad error message, got %q", err.Error())
+	}
+}
+
+func TestRequestErrorResponseWith200(t *testing.T) {
+	ts := newServer(response{200, jsonContentType, `
+{"deletesshkeypairresponse": {
+	"errorcode": 431,
+	"cserrorcode": 9999,
+	"errortext": "A key pair with name 'foo' does not exist"
+}}`}, response{200, jsonContentType, `
+{"deployvirtualmachineresponse": {
+	"errorcode": 431,
+	"cserrorcode": 9999,
+	"errortext": "Unable to deploy"
+}}`})
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+
+	resp, err := cs.Request(&DeleteSSHKeyPair{Name: "foo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if e, ok := resp.(*ErrorResponse); !ok || e.ErrorCode != ParamError {
+		t.Errorf("ErrorResponse expected, got %#v", resp)
+	}
+
+	_, err = cs.Request(&DeployVirtualMachine{
+		ServiceOfferingID: "1",
+		TemplateID:        "2",
+		ZoneID:            "3",
+	})
+	if e, ok := err.(*ErrorResponse); !ok || e.ErrorText != "Unable to deploy" {
+		t.Errorf("ErrorResponse expected, got %#v", err)
+	}
+}
+
+func TestRequestNonStreamableResponse(t *testing.T) {
+	type untagged struct {
+		Count int
+		Name  string `json:"name"`
+	}
+
+	tests := []struct {
+		body string
+		v    interface{}
+	}{
+		{`{"xresponse": {"count": 2, "name": "foo"}}`, new(untagged)},
+		{`{"xresponse": {"count": 2, "name": "foo"}}`, &map[string]interface{}{}},
+		{`{"xresponse": {"success": true}}`, &map[string]interface{}{}},
+	}
+
+	for _, test := range tests {
+		resp := &http.Response{
+			StatusCode: 200,
+			Header:     http.Header{"Content-Type": {jsonContentType}},
+			Body:       ioutil.NopCloser(strings.NewReader(test.body)),
+		}
+
+		cs := NewClient("http://localhost", "KEY", "SECRET")
+		if err := cs.parseResponse(resp, "xresponse", test.v, new(ErrorResponse)); err != nil {
+			t.Fatal(err)
+		}
+
+		switch v := test.v.(type) {
+		case *untagged:
+			if v.Count != 2 || v.Name != "foo" {
+				t.Errorf("untagged doesn't match, got %#v", v)
+			}
+		case *map[string]interface{}:
+			if len(*v) == 0 {
+				t.Errorf("map was expected to be filled, got %#v", v)
+			}
+		}
+	}
+}
+
+func BenchmarkListVirtualMachinesResponse(b *testing.B) {
+	vms := make([]string, 50)
+	for i := range vms {
+		vms[i] = fmt.Sprintf(`{"id": "%d", "name": "vm-%d", "state": "Running", "nic": [{"id": "%d", "isdefault": true, "ipaddress": "10.0.0.%d"}]}`, i, i, i, i)
+	}
+	body := fmt.Sprintf(`{"listvirtualmachinesresponse": {"count": %d, "virtualmachine": [%s]}}`, len(vms), strings.Join(vms, ","))
+
+	ts := newSleepyServer(0, 200, jsonContentType, body)
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := cs.Request(&ListVirtualMachines{}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
 type response struct {
 	code        int
 	contentType string
@@ -576,6 +743,26 @@ func newServer(responses ...response) *httptest.Server {
 	return httptest.NewServer(mux)
 }
 
+// newRecordingServer behaves like newServer and keeps the query of each request
+func newRecordingServer(queries *[]url.Values, responses ...response) *httptest.Server {
+	i := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		*queries = append(*queries, r.URL.Query())
+		if i >= len(responses) {
+			w.Header().Set("Content-Type", jsonContentType)
+			w.WriteHeader(500)
+			w.Write([]byte("{}"))
+			return
+		}
+		w.Header().Set("Content-Type", responses[i].contentType)
+		w.WriteHeader(responses[i].code)
+		w.Write([]byte(responses[i].body))
+		i++
+	})
+	return httptest.NewServer(mux)
+}
+
 func newSleepyServer(sleep time.Duration, code int, contentType, response string) *httptest.Server {
 	mux := http.NewServeMux()
 	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
@@ -1,5 +1,117 @@
 package egoscale
 
+import (
+	"context"
+	"fmt"
+)
+
+// ResourceDetails fetches the details of the given resource
+func (client *Client) ResourceDetails(ctx context.Context, resource Taggable) (map[string]string, error) {
+	if resource.ResourceID() == "" {
+		return nil, fmt.Errorf("the details of a %s may only be fetched using ID", resource.ResourceType())
+	}
+
+	req := &ListResourceDetails{
+		ResourceID:   resource.ResourceID(),
+		ResourceType: resource.ResourceType(),
+	}
+
+	details := make(map[string]string)
+
+	var err error
+	client.PaginateWithContext(ctx, req, func(item interface{}, e error) bool {
+		if e != nil {
+			err = e
+			return false
+		}
+		detail := item.(*ResourceTag)
+		details[detail.Key] = detail.Value
+		return true
+	})
+
+	return details, err
+}
+
+// SetResourceDetails adds or updates the details of the given resource
+func (client *Client) SetResourceDetails(ctx context.Context, resource Taggable, details map[string]string) error {
+	if resource.ResourceID() == "" {
+		return fmt.Errorf("the details of a %s may only be set using ID", resource.ResourceType())
+	}
+
+	if len(details) == 0 {
+		return nil
+	}
+
+	return client.BooleanRequestWithContext(ctx, &AddResourceDetail{
+		Details:      details,
+		ResourceID:   resource.ResourceID(),
+		ResourceType: resource.ResourceType(),
+	})
+}
+
+// RemoveResourceDetails removes the given keys from the details of the resource
+//
+// At least one key is required, see RemoveAllResourceDetails to remove all of them.
+func (client *Client) RemoveResourceDetails(ctx context.Context, resource Taggable, keys ...string) error {
+	if len(keys) == 0 {
+		return fmt.Errorf("at least one key is required to remove the details of a %s", resource.ResourceType())
+	}
+
+	for _, key := range keys {
+		if key == "" {
+			return fmt.Errorf("an empty key would remove all the details of the %s", resource.ResourceType())
+		}
+
+		if err := client.removeResourceDetail(ctx, resource, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RemoveAllResourceDetails removes all the details of the resource
+func (client *Client) RemoveAllResourceDetails(ctx context.Context, resource Taggable) error {
+	return client.removeResourceDetail(ctx, resource, "")
+}
+
+// removeResourceDetail removes the detail of the resource, or all of them without a key
+func (client *Client) removeResourceDetail(ctx context.Context, resource Taggable, key string) error {
+	if resource.ResourceID() == "" {
+		return fmt.Errorf("the details of a %s may only be removed using ID", resource.ResourceType())
+	}
+
+	return client.BooleanRequestWithContext(ctx, &RemoveResourceDetail{
+		Key:          key,
+		ResourceID:   resource.ResourceID(),
+		ResourceType: resource.ResourceType(),
+	})
+}
+
+func (*AddResourceDetail) name() string {
+	return "addResourceDetail"
+}
+
+func (*AddResourceDetail) description() string {
+	return "Adds detail for the Resource."
+}
+
+func (*AddResourceDetail) asyncResponse() interface{} {
+	return new(booleanResponse)
+}
+
+func (*RemoveResourceDetail) name() string {
+	return "removeResourceDetail"
+}
+
+func (*RemoveResourceDetail) description() string {
+	return "Removes detail for the Resource."
+}
+
+func (*RemoveResourceDetail) asyncResponse() interface{} {
+	return new(booleanResponse)
+}
+
 func (*ListResourceDetails) name() string {
 	return "listResourceDetails"
 }
@@ -11,3 +123,27 @@ func (*ListResourceDetails) description() string {
 func (*ListResourceDetails) response() interface{} {
 	return new(ListResourceDetailsResponse)
 }
+
+// SetPage sets the current page
+func (ls *ListResourceDetails) SetPage(page int) {
+	ls.Page = page
+}
+
+// SetPageSize sets the page size
+func (ls *ListResourceDetails) SetPageSize(pageSize int) {
+	ls.PageSize = pageSize
+}
+
+func (*ListResourceDetails) each(resp interface{}, callback IterateItemFunc) {
+	details, ok := resp.(*ListResourceDetailsResponse)
+	if !ok {
+		callback(nil, fmt.Errorf("wrong type. ListResourceDetailsResponse expected, got %T", resp))
+		return
+	}
+
+	for i := range details.ResourceDetail {
+		if !callback(&details.ResourceDetail[i], nil) {
+			break
+		}
+	}
+}
@@ -1,11 +1,16 @@
 package egoscale
 
 import (
+	"context"
+	"net/url"
 	"testing"
 )
 
 func TestResourceMetadata(t *testing.T) {
 	var _ Command = (*ListResourceDetails)(nil)
+	var _ ListCommand = (*ListResourceDetails)(nil)
+	var _ AsyncCommand = (*AddResourceDetail)(nil)
+	var _ AsyncCommand = (*RemoveResourceDetail)(nil)
 }
 
 func TestListResourceDetailss(t *testing.T) {
@@ -15,3 +20,130 @@ func TestListResourceDetailss(t *testing.T) {
 	}
 	_ = req.response().(*ListResourceDetailsResponse)
 }
+
+func TestAddResourceDetail(t *testing.T) {
+	req := &AddResourceDetail{}
+	if req.name() != "addResourceDetail" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.asyncResponse().(*booleanResponse)
+}
+
+func TestRemoveResourceDetail(t *testing.T) {
+	req := &RemoveResourceDetail{}
+	if req.name() != "removeResourceDetail" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.asyncResponse().(*booleanResponse)
+}
+
+func TestClientResourceDetails(t *testing.T) {
+	ts := newServer(response{200, jsonContentType, `
+{"listresourcedetailsresponse": {
+	"count": 1,
+	"resourcedetail": [
+		{"key": "username", "value": "ubuntu", "resourceid": "t1", "resourcetype": "Template"}
+	]
+}}`})
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+
+	details, err := cs.ResourceDetails(context.TODO(), &Template{ID: "t1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if details["username"] != "ubuntu" {
+		t.Errorf("bad details, got %v", details)
+	}
+}
+
+func TestClientSetResourceDetails(t *testing.T) {
+	queries := make([]url.Values, 0)
+	ts := newRecordingServer(&queries,
+		response{200, jsonContentType, `{"addresourcedetailresponse": {"jobid": "1", "jobresult": {"success": true}, "jobstatus": 1}}`},
+	)
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+
+	err := cs.SetResourceDetails(context.TODO(), &Template{ID: "t1"}, map[string]string{"username": "ubuntu"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	query := queries[0]
+	if query.Get("details[0].key") != "username" || query.Get("details[0].value") != "ubuntu" {
+		t.Errorf("the details were expected as key/value pairs, got %v", query)
+	}
+
+	if query.Get("resourceid") != "t1" || query.Get("resourcetype") != "Template" {
+		t.Errorf("bad resource, got %v", query)
+	}
+}
+
+func TestClientRemoveResourceDetails(t *testing.T) {
+	queries := make([]url.Values, 0)
+	ts := newRecordingServer(&queries,
+		response{200, jsonContentType, `{"removeresourcedetailresponse": {"jobid": "1", "jobresult": {"success": true}, "jobstatus": 1}}`},
+		response{200, jsonContentType, `{"removeresourcedetailresponse": {"jobid": "2", "jobresult": {"success": true}, "jobstatus": 1}}`},
+	)
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+
+	if err := cs.RemoveResourceDetails(context.TODO(), &Volume{ID: "v1"}, "a", "b"); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(queries) != 2 || queries[0].Get("key") != "a" || queries[1].Get("key") != "b" {
+		t.Errorf("one call per key was expected, got %v", queries)
+	}
+}
+
+func TestClientRemoveAllResourceDetails(t *testing.T) {
+	queries := make([]url.Values, 0)
+	ts := newRecordingServer(&queries,
+		response{200, jsonContentType, `{"removeresourcedetailresponse": {"jobid": "1", "jobresult": {"success": true}, "jobstatus": 1}}`},
+	)
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+
+	if err := cs.RemoveAllResourceDetails(context.TODO(), &Volume{ID: "v1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(queries) != 1 || queries[0].Get("key") != "" || queries[0].Get("resourceid") != "v1" {
+		t.Errorf("one call without key was expected, got %v", queries)
+	}
+}
+
+func TestClientResourceDetailsFailure(t *testing.T) {
+	ts := newServer()
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+	ctx := context.TODO()
+
+	if _, err := cs.ResourceDetails(ctx, &Volume{}); err == nil {
+		t.Error("an error was expected without ID")
+	}
+
+	if err := cs.SetResourceDetails(ctx, &Volume{}, map[string]string{"a": "b"}); err == nil {
+		t.Error("an error was expected without ID")
+	}
+
+	if err := cs.RemoveResourceDetails(ctx, &Volume{ID: "v1"}); err == nil {
+		t.Error("an error was expected without keys")
+	}
+
+	if err := cs.RemoveResourceDetails(ctx, &Volume{ID: "v1"}, ""); err == nil {
+		t.Error("an error was expected with an empty key")
+	}
+
+	if err := cs.RemoveAllResourceDetails(ctx, &Volume{}); err == nil {
+		t.Error("an error was expected without ID")
+	}
+}
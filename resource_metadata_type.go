@@ -1,5 +1,26 @@
 package egoscale
 
+// AddResourceDetail (Async) adds detail for the Resource
+//
+// CloudStack API: https://cloudstack.apache.org/api/apidocs-4.10/apis/addResourceDetail.html
+type AddResourceDetail struct {
+	Details      map[string]string `json:"details" map:"keyvalue" doc:"Map of (key/value pairs)"`
+	ResourceID   string            `json:"resourceid" doc:"resource id to create the details for"`
+	ResourceType string            `json:"resourcetype" doc:"type of the resource"`
+	ForDisplay   *bool             `json:"fordisplay,omitempty" doc:"pass false if you want this detail to be disabled for the regular user. True by default"`
+}
+
+// RemoveResourceDetail (Async) removes the details of the Resource
+//
+// Without a key, all the details of the resource are removed.
+//
+// CloudStack API: https://cloudstack.apache.org/api/apidocs-4.10/apis/removeResourceDetail.html
+type RemoveResourceDetail struct {
+	ResourceID   string `json:"resourceid" doc:"Delete details for resource id"`
+	ResourceType string `json:"resourcetype" doc:"Delete detail by resource type"`
+	Key          string `json:"key,omitempty" doc:"Delete details matching key/value pairs"`
+}
+
 // ListResourceDetails lists the resource tag(s) (but different from listTags...)
 //
 // CloudStack API: http://cloudstack.apache.org/api/apidocs-4.10/apis/listResourceDetails.html
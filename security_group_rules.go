@@ -0,0 +1,376 @@
+package egoscale
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+)
+
+// ruleKey identifies a rule having a single CIDR or security group
+type ruleKey struct {
+	direction RuleDirection
+	protocol  string
+	startPort uint16
+	endPort   uint16
+	icmpType  uint8
+	icmpCode  uint8
+	cidr      string
+	group     string
+	account   string
+}
+
+// authorizeKey groups the rules which may be authorized together
+type authorizeKey struct {
+	ruleKey
+	description string
+}
+
+// NewSecurityGroupPlan computes the authorizations and revocations turning
+// the security group rules into the desired ones
+//
+// Existing rules are compared by direction, protocol, ports, ICMP type and
+// code, CIDR and security group, their description is ignored. The rules left
+// untouched are never revoked, the duplicated ones are.
+func NewSecurityGroupPlan(sg *SecurityGroup, rules []SecurityGroupRule) (*SecurityGroupPlan, error) {
+	plan := &SecurityGroupPlan{
+		SecurityGroup: sg,
+	}
+
+	existing := sg.rules()
+	exists := make(map[ruleKey]bool, len(existing))
+	for _, rule := range existing {
+		exists[rule.key()] = true
+	}
+
+	desired := make(map[ruleKey]bool)
+	authorize := make(map[authorizeKey]int)
+	for _, rule := range rules {
+		r, err := normalizeRule(rule, sg.Account)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, atom := range splitRule(r) {
+			key := atom.key()
+			if desired[key] {
+				continue
+			}
+			desired[key] = true
+
+			if exists[key] {
+				continue
+			}
+
+			// the additions sharing everything but the CIDR and group are authorized together
+			group := atom
+			group.CidrList = nil
+			group.UserSecurityGroupList = nil
+			groupKey := authorizeKey{group.key(), group.Description}
+
+			i, ok := authorize[groupKey]
+			if !ok {
+				i = len(plan.Authorize)
+				authorize[groupKey] = i
+				plan.Authorize = append(plan.Authorize, group)
+			}
+			plan.Authorize[i].CidrList = append(plan.Authorize[i].CidrList, atom.CidrList...)
+			plan.Authorize[i].UserSecurityGroupList = append(plan.Authorize[i].UserSecurityGroupList, atom.UserSecurityGroupList...)
+		}
+	}
+
+	seen := make(map[ruleKey]bool)
+	for _, rule := range existing {
+		key := rule.key()
+		if desired[key] && !seen[key] {
+			seen[key] = true
+			continue
+		}
+		plan.Revoke = append(plan.Revoke, rule)
+	}
+
+	return plan, nil
+}
+
+// IsEmpty tells whether the security group already has the desired rules
+func (plan *SecurityGroupPlan) IsEmpty() bool {
+	return len(plan.Authorize) == 0 && len(plan.Revoke) == 0
+}
+
+// String describes the plan, one change per line
+func (plan *SecurityGroupPlan) String() string {
+	lines := make([]string, 0, len(plan.Authorize)+len(plan.Revoke))
+	for _, rule := range plan.Authorize {
+		lines = append(lines, "+ "+rule.String())
+	}
+	for _, rule := range plan.Revoke {
+		lines = append(lines, "- "+rule.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// String describes the rule, e.g. "ingress tcp 22 0.0.0.0/0"
+func (rule SecurityGroupRule) String() string {
+	fields := []string{string(rule.Direction), rule.Protocol}
+
+	switch {
+	case isPortProtocol(rule.Protocol):
+		if rule.StartPort == rule.EndPort {
+			fields = append(fields, fmt.Sprintf("%d", rule.StartPort))
+		} else {
+			fields = append(fields, fmt.Sprintf("%d-%d", rule.StartPort, rule.EndPort))
+		}
+	case isICMPProtocol(rule.Protocol):
+		fields = append(fields, fmt.Sprintf("type %d code %d", rule.IcmpType, rule.IcmpCode))
+	}
+
+	targets := append([]string{}, rule.CidrList...)
+	for _, group := range rule.UserSecurityGroupList {
+		if group.Account != "" {
+			targets = append(targets, group.Account+"/"+group.Group)
+		} else {
+			targets = append(targets, group.Group)
+		}
+	}
+	fields = append(fields, strings.Join(targets, ","))
+
+	if rule.RuleID != "" {
+		fields = append(fields, "("+rule.RuleID+")")
+	}
+
+	return strings.Join(fields, " ")
+}
+
+// ApplySecurityGroupPlan authorizes then revokes the rules of the plan
+//
+// Authorizing first never leaves the security group without the desired rules.
+// It stops at the first failure, the changes made so far are kept.
+func (client *Client) ApplySecurityGroupPlan(ctx context.Context, plan *SecurityGroupPlan) error {
+	sg := plan.SecurityGroup
+	if sg.ID == "" {
+		return fmt.Errorf("a SecurityGroup may only be reconciled using ID")
+	}
+
+	for _, rule := range plan.Authorize {
+		req := &AuthorizeSecurityGroupIngress{
+			SecurityGroupID:       sg.ID,
+			Protocol:              rule.Protocol,
+			CidrList:              rule.CidrList,
+			UserSecurityGroupList: rule.UserSecurityGroupList,
+			StartPort:             rule.StartPort,
+			EndPort:               rule.EndPort,
+			IcmpType:              rule.IcmpType,
+			IcmpCode:              rule.IcmpCode,
+			Description:           rule.Description,
+		}
+
+		var err error
+		if rule.Direction == Egress {
+			_, err = client.RequestWithContext(ctx, (*AuthorizeSecurityGroupEgress)(req))
+		} else {
+			_, err = client.RequestWithContext(ctx, req)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, rule := range plan.Revoke {
+		req := &RevokeSecurityGroupIngress{
+			ID: rule.RuleID,
+		}
+
+		var err error
+		if rule.Direction == Egress {
+			err = client.BooleanRequestWithContext(ctx, (*RevokeSecurityGroupEgress)(req))
+		} else {
+			err = client.BooleanRequestWithContext(ctx, req)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ReconcileSecurityGroup brings the security group to the desired rules
+//
+// The security group is loaded first, by ID or Name. With dryRun, the plan is
+// only computed.
+func (client *Client) ReconcileSecurityGroup(ctx context.Context, sg *SecurityGroup, rules []SecurityGroupRule, dryRun bool) (*SecurityGroupPlan, error) {
+	if err := client.GetWithContext(ctx, sg); err != nil {
+		return nil, err
+	}
+
+	plan, err := NewSecurityGroupPlan(sg, rules)
+	if err != nil {
+		return nil, err
+	}
+
+	if dryRun || plan.IsEmpty() {
+		return plan, nil
+	}
+
+	return plan, client.ApplySecurityGroupPlan(ctx, plan)
+}
+
+// rules returns the existing ingress and egress rules, one CIDR or security group each
+func (sg *SecurityGroup) rules() []SecurityGroupRule {
+	rules := make([]SecurityGroupRule, 0, len(sg.IngressRule)+len(sg.EgressRule))
+	for _, in := range sg.IngressRule {
+		rules = append(rules, existingRule(Ingress, in, sg.Account)...)
+	}
+	for _, out := range sg.EgressRule {
+		rules = append(rules, existingRule(Egress, IngressRule(out), sg.Account)...)
+	}
+	return rules
+}
+
+// existingRule converts the rule returned by the API
+func existingRule(direction RuleDirection, in IngressRule, account string) []SecurityGroupRule {
+	rule := SecurityGroupRule{
+		Direction:   direction,
+		Protocol:    in.Protocol,
+		StartPort:   in.StartPort,
+		EndPort:     in.EndPort,
+		IcmpType:    in.IcmpType,
+		IcmpCode:    in.IcmpCode,
+		Description: in.Description,
+		RuleID:      in.RuleID,
+	}
+
+	if in.Cidr != "" {
+		rule.CidrList = []string{in.Cidr}
+	}
+
+	if in.SecurityGroupName != "" {
+		rule.UserSecurityGroupList = []UserSecurityGroup{{
+			Group:   in.SecurityGroupName,
+			Account: in.Account,
+		}}
+	} else {
+		rule.UserSecurityGroupList = in.UserSecurityGroupList
+	}
+
+	// the API rules are valid, only the defaults are applied
+	r, err := normalizeRule(rule, account)
+	if err != nil {
+		r = rule
+	}
+
+	return splitRule(r)
+}
+
+// normalizeRule validates the rule and applies the defaults
+func normalizeRule(rule SecurityGroupRule, account string) (SecurityGroupRule, error) {
+	r := rule
+
+	switch r.Direction {
+	case Ingress, Egress:
+	case "":
+		r.Direction = Ingress
+	default:
+		return r, fmt.Errorf("invalid rule direction %q, ingress or egress expected", r.Direction)
+	}
+
+	r.Protocol = strings.ToLower(r.Protocol)
+	if r.Protocol == "" {
+		r.Protocol = "tcp"
+	}
+
+	if isPortProtocol(r.Protocol) {
+		if r.EndPort == 0 {
+			r.EndPort = r.StartPort
+		}
+		if r.EndPort < r.StartPort {
+			return r, fmt.Errorf("invalid port range %d-%d", r.StartPort, r.EndPort)
+		}
+	} else {
+		r.StartPort = 0
+		r.EndPort = 0
+	}
+
+	if !isICMPProtocol(r.Protocol) {
+		r.IcmpType = 0
+		r.IcmpCode = 0
+	}
+
+	if len(r.CidrList) == 0 && len(r.UserSecurityGroupList) == 0 {
+		return r, fmt.Errorf("a rule requires at least one CIDR or security group")
+	}
+
+	r.CidrList = make([]string, len(rule.CidrList))
+	for i, cidr := range rule.CidrList {
+		_, network, err := net.ParseCIDR(strings.TrimSpace(cidr))
+		if err != nil {
+			return r, err
+		}
+		r.CidrList[i] = network.String()
+	}
+
+	r.UserSecurityGroupList = make([]UserSecurityGroup, len(rule.UserSecurityGroupList))
+	for i, group := range rule.UserSecurityGroupList {
+		if group.Group == "" {
+			return r, fmt.Errorf("a security group reference requires a group name")
+		}
+		if group.Account == "" {
+			group.Account = account
+		}
+		r.UserSecurityGroupList[i] = group
+	}
+
+	return r, nil
+}
+
+// splitRule returns one rule per CIDR and security group
+func splitRule(rule SecurityGroupRule) []SecurityGroupRule {
+	rules := make([]SecurityGroupRule, 0, len(rule.CidrList)+len(rule.UserSecurityGroupList))
+
+	for _, cidr := range rule.CidrList {
+		r := rule
+		r.CidrList = []string{cidr}
+		r.UserSecurityGroupList = nil
+		rules = append(rules, r)
+	}
+
+	for _, group := range rule.UserSecurityGroupList {
+		r := rule
+		r.CidrList = nil
+		r.UserSecurityGroupList = []UserSecurityGroup{group}
+		rules = append(rules, r)
+	}
+
+	return rules
+}
+
+// key identifies the rule, it expects at most one CIDR or security group
+func (rule SecurityGroupRule) key() ruleKey {
+	key := ruleKey{
+		direction: rule.Direction,
+		protocol:  rule.Protocol,
+		startPort: rule.StartPort,
+		endPort:   rule.EndPort,
+		icmpType:  rule.IcmpType,
+		icmpCode:  rule.IcmpCode,
+	}
+
+	if len(rule.CidrList) > 0 {
+		key.cidr = rule.CidrList[0]
+	}
+
+	if len(rule.UserSecurityGroupList) > 0 {
+		key.group = rule.UserSecurityGroupList[0].Group
+		key.account = rule.UserSecurityGroupList[0].Account
+	}
+
+	return key
+}
+
+func isPortProtocol(protocol string) bool {
+	return protocol == "tcp" || protocol == "udp"
+}
+
+func isICMPProtocol(protocol string) bool {
+	return strings.HasPrefix(protocol, "icmp")
+}
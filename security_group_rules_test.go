@@ -0,0 +1,249 @@
+package egoscale
+
+import (
+	"context"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func testSecurityGroup() *SecurityGroup {
+	return &SecurityGroup{
+		ID:      "sg",
+		Name:    "web",
+		Account: "alice",
+		IngressRule: []IngressRule{
+			{RuleID: "ssh", Protocol: "tcp", StartPort: 22, EndPort: 22, Cidr: "0.0.0.0/0"},
+			{RuleID: "http", Protocol: "TCP", StartPort: 80, EndPort: 80, Cidr: "0.0.0.0/0", Description: "old"},
+			{RuleID: "http-dup", Protocol: "tcp", StartPort: 80, EndPort: 80, Cidr: "0.0.0.0/0"},
+			{RuleID: "ping", Protocol: "icmp", IcmpType: 8, Cidr: "0.0.0.0/0"},
+			{RuleID: "db", Protocol: "tcp", StartPort: 5432, EndPort: 5432, SecurityGroupName: "app", Account: "alice"},
+		},
+		EgressRule: []EgressRule{
+			{RuleID: "dns", Protocol: "udp", StartPort: 53, EndPort: 53, Cidr: "0.0.0.0/0"},
+		},
+	}
+}
+
+func TestNewSecurityGroupPlan(t *testing.T) {
+	rules := []SecurityGroupRule{
+		{Protocol: "tcp", StartPort: 80, CidrList: []string{"0.0.0.0/0"}, Description: "new"},
+		{Direction: Ingress, Protocol: "tcp", StartPort: 443, CidrList: []string{"0.0.0.0/0", "::/0"}},
+		{Direction: Ingress, Protocol: "icmp", IcmpType: 8, CidrList: []string{"0.0.0.0/0"}},
+		{Direction: Ingress, StartPort: 5432, UserSecurityGroupList: []UserSecurityGroup{{Group: "app"}}},
+		{Direction: Ingress, StartPort: 443, UserSecurityGroupList: []UserSecurityGroup{{Group: "lb", Account: "bob"}}},
+		{Direction: Egress, Protocol: "tcp", StartPort: 1, EndPort: 65535, CidrList: []string{"10.1.2.3/8"}},
+	}
+
+	plan, err := NewSecurityGroupPlan(testSecurityGroup(), rules)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	authorize := []SecurityGroupRule{
+		{
+			Direction:             Ingress,
+			Protocol:              "tcp",
+			StartPort:             443,
+			EndPort:               443,
+			CidrList:              []string{"0.0.0.0/0", "::/0"},
+			UserSecurityGroupList: []UserSecurityGroup{{Group: "lb", Account: "bob"}},
+		},
+		{
+			Direction: Egress,
+			Protocol:  "tcp",
+			StartPort: 1,
+			EndPort:   65535,
+			CidrList:  []string{"10.0.0.0/8"},
+		},
+	}
+	if !reflect.DeepEqual(plan.Authorize, authorize) {
+		t.Errorf("authorizations don't match, got %#v", plan.Authorize)
+	}
+
+	revoke := make([]string, len(plan.Revoke))
+	for i, rule := range plan.Revoke {
+		revoke[i] = rule.RuleID
+	}
+	if !reflect.DeepEqual(revoke, []string{"ssh", "http-dup", "dns"}) {
+		t.Errorf("revocations don't match, got %v", revoke)
+	}
+
+	expected := `+ ingress tcp 443 0.0.0.0/0,::/0,bob/lb
++ egress tcp 1-65535 10.0.0.0/8
+- ingress tcp 22 0.0.0.0/0 (ssh)
+- ingress tcp 80 0.0.0.0/0 (http-dup)
+- egress udp 53 0.0.0.0/0 (dns)`
+	if plan.String() != expected {
+		t.Errorf("plan doesn't match, got\n%s", plan)
+	}
+}
+
+func TestNewSecurityGroupPlanEmpty(t *testing.T) {
+	plan, err := NewSecurityGroupPlan(testSecurityGroup(), []SecurityGroupRule{
+		{StartPort: 22, CidrList: []string{"0.0.0.0/0"}},
+		{StartPort: 80, CidrList: []string{"0.0.0.0/0"}},
+		{Protocol: "icmp", IcmpType: 8, CidrList: []string{"0.0.0.0/0"}},
+		{StartPort: 5432, UserSecurityGroupList: []UserSecurityGroup{{Group: "app", Account: "alice"}}},
+		{Direction: Egress, Protocol: "udp", StartPort: 53, CidrList: []string{"0.0.0.0/0"}},
+		{StartPort: 80, CidrList: []string{"0.0.0.0/0"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(plan.Authorize) != 0 {
+		t.Errorf("no authorizations were expected, got %v", plan.Authorize)
+	}
+
+	if len(plan.Revoke) != 1 || plan.Revoke[0].RuleID != "http-dup" {
+		t.Errorf("only the duplicated rule was expected to be revoked, got %v", plan.Revoke)
+	}
+}
+
+func TestNewSecurityGroupPlanDescriptions(t *testing.T) {
+	plan, err := NewSecurityGroupPlan(testSecurityGroup(), []SecurityGroupRule{
+		{StartPort: 8080, CidrList: []string{"10.0.0.0/8"}, Description: "office"},
+		{StartPort: 8080, CidrList: []string{"192.168.0.0/16"}, Description: "vpn"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	authorize := []SecurityGroupRule{
+		{Direction: Ingress, Protocol: "tcp", StartPort: 8080, EndPort: 8080, CidrList: []string{"10.0.0.0/8"}, Description: "office"},
+		{Direction: Ingress, Protocol: "tcp", StartPort: 8080, EndPort: 8080, CidrList: []string{"192.168.0.0/16"}, Description: "vpn"},
+	}
+	if !reflect.DeepEqual(plan.Authorize, authorize) {
+		t.Errorf("the descriptions were expected to be kept apart, got %#v", plan.Authorize)
+	}
+}
+
+func TestNewSecurityGroupPlanFailure(t *testing.T) {
+	rules := []SecurityGroupRule{
+		{Direction: "sideways", CidrList: []string{"0.0.0.0/0"}},
+		{StartPort: 22},
+		{StartPort: 22, CidrList: []string{"0.0.0.0"}},
+		{StartPort: 80, EndPort: 22, CidrList: []string{"0.0.0.0/0"}},
+		{StartPort: 22, UserSecurityGroupList: []UserSecurityGroup{{Account: "alice"}}},
+	}
+
+	for _, rule := range rules {
+		if _, err := NewSecurityGroupPlan(testSecurityGroup(), []SecurityGroupRule{rule}); err == nil {
+			t.Errorf("an error was expected for %#v", rule)
+		}
+	}
+}
+
+func TestReconcileSecurityGroupDryRun(t *testing.T) {
+	var queries []url.Values
+	ts := newRecordingServer(&queries, response{200, jsonContentType, `
+{"listsecuritygroupsresponse": {
+	"count": 1,
+	"securitygroup": [
+		{
+			"id": "sg",
+			"name": "web",
+			"ingressrule": [
+				{"ruleid": "ssh", "protocol": "tcp", "startport": 22, "endport": 22, "cidr": "0.0.0.0/0"}
+			]
+		}
+	]
+}}`})
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+	plan, err := cs.ReconcileSecurityGroup(context.Background(), &SecurityGroup{Name: "web"}, []SecurityGroupRule{
+		{StartPort: 443, CidrList: []string{"0.0.0.0/0"}},
+	}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(plan.Authorize) != 1 || len(plan.Revoke) != 1 {
+		t.Errorf("one authorization and one revocation were expected, got %s", plan)
+	}
+
+	if len(queries) != 1 {
+		t.Errorf("only the listing was expected, got %d requests", len(queries))
+	}
+}
+
+func TestReconcileSecurityGroup(t *testing.T) {
+	var queries []url.Values
+	ts := newRecordingServer(&queries, response{200, jsonContentType, `
+{"listsecuritygroupsresponse": {
+	"count": 1,
+	"securitygroup": [
+		{
+			"id": "sg",
+			"name": "web",
+			"ingressrule": [
+				{"ruleid": "ssh", "protocol": "tcp", "startport": 22, "endport": 22, "cidr": "0.0.0.0/0"}
+			],
+			"egressrule": [
+				{"ruleid": "dns", "protocol": "udp", "startport": 53, "endport": 53, "cidr": "0.0.0.0/0"}
+			]
+		}
+	]
+}}`}, response{200, jsonContentType, `
+{"authorizesecuritygroupingressresponse": {
+	"jobid": "1",
+	"jobresult": {"securitygroup": {"id": "sg", "name": "web"}},
+	"jobstatus": 1
+}}`}, response{200, jsonContentType, `
+{"authorizesecuritygroupegressresponse": {
+	"jobid": "2",
+	"jobresult": {"securitygroup": {"id": "sg", "name": "web"}},
+	"jobstatus": 1
+}}`}, response{200, jsonContentType, `
+{"revokesecuritygroupingressresponse": {
+	"jobid": "3",
+	"jobresult": {"success": true},
+	"jobstatus": 1
+}}`})
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+	_, err := cs.ReconcileSecurityGroup(context.Background(), &SecurityGroup{Name: "web"}, []SecurityGroupRule{
+		{Protocol: "icmp", IcmpType: 8, CidrList: []string{"0.0.0.0/0"}},
+		{Direction: Egress, Protocol: "udp", StartPort: 53, CidrList: []string{"0.0.0.0/0"}},
+		{Direction: Egress, Protocol: "tcp", StartPort: 443, CidrList: []string{"0.0.0.0/0"}},
+	}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	commands := make([]string, len(queries))
+	for i, query := range queries {
+		commands[i] = query.Get("command")
+	}
+	expected := []string{
+		"listSecurityGroups",
+		"authorizeSecurityGroupIngress",
+		"authorizeSecurityGroupEgress",
+		"revokeSecurityGroupIngress",
+	}
+	if !reflect.DeepEqual(commands, expected) {
+		t.Fatalf("commands don't match, got %v", commands)
+	}
+
+	if queries[1].Get("securitygroupid") != "sg" || queries[1].Get("icmptype") != "8" || queries[1].Get("icmpcode") != "0" {
+		t.Errorf("ingress authorization doesn't match, got %v", queries[1])
+	}
+
+	if queries[3].Get("id") != "ssh" {
+		t.Errorf("revocation doesn't match, got %v", queries[3])
+	}
+}
+
+func TestApplySecurityGroupPlanFailure(t *testing.T) {
+	cs := NewClient("http://localhost", "KEY", "SECRET")
+	plan := &SecurityGroupPlan{
+		SecurityGroup: &SecurityGroup{Name: "web"},
+	}
+
+	if err := cs.ApplySecurityGroupPlan(context.Background(), plan); err == nil {
+		t.Error("an error was expected for a security group without ID")
+	}
+}
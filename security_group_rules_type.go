@@ -0,0 +1,34 @@
+package egoscale
+
+// RuleDirection represents the traffic direction of a security group rule
+type RuleDirection string
+
+const (
+	// Ingress represents the incoming traffic
+	Ingress RuleDirection = "ingress"
+	// Egress represents the outgoing traffic
+	Egress RuleDirection = "egress"
+)
+
+// SecurityGroupRule represents a desired security group rule, see NewSecurityGroupPlan
+//
+// A rule matches the traffic from (or to) any of the CIDRs and any of the security groups.
+type SecurityGroupRule struct {
+	Direction             RuleDirection       `json:"direction" doc:"ingress or egress"`
+	Protocol              string              `json:"protocol,omitempty" doc:"tcp by default, udp, icmp, icmpv6, ah, esp or gre"`
+	CidrList              []string            `json:"cidrlist,omitempty" doc:"the CIDRs the traffic comes from (or goes to)"`
+	UserSecurityGroupList []UserSecurityGroup `json:"usersecuritygrouplist,omitempty" doc:"the security groups the traffic comes from (or goes to)"`
+	StartPort             uint16              `json:"startport,omitempty" doc:"the first port, tcp and udp only"`
+	EndPort               uint16              `json:"endport,omitempty" doc:"the last port, the start port by default, tcp and udp only"`
+	IcmpType              uint8               `json:"icmptype,omitempty" doc:"the type of the ICMP message, icmp and icmpv6 only"`
+	IcmpCode              uint8               `json:"icmpcode,omitempty" doc:"the code of the ICMP message, icmp and icmpv6 only"`
+	Description           string              `json:"description,omitempty" doc:"the description of the rule, it isn't compared with the existing rules"`
+	RuleID                string              `json:"ruleid,omitempty" doc:"the ID of the existing rule, set on the rules to revoke"`
+}
+
+// SecurityGroupPlan represents the changes bringing a security group to the desired rules
+type SecurityGroupPlan struct {
+	SecurityGroup *SecurityGroup      `json:"securitygroup"`
+	Authorize     []SecurityGroupRule `json:"authorize,omitempty" doc:"the rules to authorize, grouped by CIDRs and security groups"`
+	Revoke        []SecurityGroupRule `json:"revoke,omitempty" doc:"the existing rules to revoke, one CIDR or security group each"`
+}
@@ -6,8 +6,6 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
-
-	"github.com/jinzhu/copier"
 )
 
 // ResourceType returns the type of the resource
@@ -15,41 +13,24 @@ func (*SecurityGroup) ResourceType() string {
 	return "SecurityGroup"
 }
 
+// ResourceID returns the identifier of the resource
+func (sg *SecurityGroup) ResourceID() string {
+	return sg.ID
+}
+
 // Get loads the given Security Group
 func (sg *SecurityGroup) Get(ctx context.Context, client *Client) error {
-	resp, err := client.ListWithContext(ctx, sg)
-	if err != nil {
-		return err
-	}
-
-	listSize := len(resp)
-
-	if listSize == 0 {
-		err := &ErrorResponse{
-			ErrorCode: ParamError,
-			ErrorText: fmt.Sprintf("missing SecurityGroup. %#v", sg),
-		}
-		return err
-	} else if listSize > 1 {
-		return fmt.Errorf("more than one SecurityGroup was found. Query: id: %s, name: %s", sg.ID, sg.Name)
-	}
-
-	securGroup, ok := resp[0].(*SecurityGroup)
-	if !ok {
-		return fmt.Errorf("wrong type. SecurityGroup expected, got %T", resp[0])
-	}
-
-	return copier.Copy(sg, securGroup)
+	return client.GetWithContext(ctx, sg)
 }
 
 // ListRequest builds the ListSecurityGroups request
 func (sg *SecurityGroup) ListRequest() (ListCommand, error) {
-	//TODO add tags
 	req := &ListSecurityGroups{
 		Account:           sg.Account,
 		DomainID:          sg.DomainID,
 		ID:                sg.ID,
 		SecurityGroupName: sg.Name,
+		Tags:              tagFilter(sg.Tags),
 	}
 
 	return req, nil
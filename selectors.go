@@ -0,0 +1,311 @@
+package egoscale
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// ParseSelector reads a comma separated list of requirements
+//
+// The supported requirements are:
+//
+//	env=prod
+//	env!=prod
+//	env in (staging, prod)
+//	env notin (staging, prod)
+//	env exists (or simply env)
+func ParseSelector(selector string) (Selector, error) {
+	parts, err := splitRequirements(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	s := make(Selector, 0, len(parts))
+	for _, part := range parts {
+		r, err := parseRequirement(part)
+		if err != nil {
+			return nil, err
+		}
+		s = append(s, *r)
+	}
+
+	return s, nil
+}
+
+// splitRequirements splits the selector on the commas which are not within parenthesis
+func splitRequirements(selector string) ([]string, error) {
+	parts := make([]string, 0)
+	depth := 0
+	start := 0
+
+	for i, c := range selector {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("unexpected ')' in selector %q", selector)
+			}
+		case ',':
+			if depth == 0 {
+				parts = append(parts, selector[start:i])
+				start = i + 1
+			}
+		}
+	}
+
+	if depth != 0 {
+		return nil, fmt.Errorf("missing ')' in selector %q", selector)
+	}
+
+	parts = append(parts, selector[start:])
+
+	// an empty selector has no requirements
+	if len(parts) == 1 && strings.TrimSpace(parts[0]) == "" {
+		return parts[:0], nil
+	}
+
+	return parts, nil
+}
+
+// setRequirementRegexp matches the "key in (values)" and "key notin (values)" requirements
+var setRequirementRegexp = regexp.MustCompile(`^(\S+)\s+(in|notin)\s*\((.*)\)$`)
+
+func parseRequirement(requirement string) (*Requirement, error) {
+	requirement = strings.TrimSpace(requirement)
+
+	// the values of a set may contain anything, even = or !=
+	if m := setRequirementRegexp.FindStringSubmatch(requirement); m != nil {
+		values := strings.Split(m[3], ",")
+		return newRequirement(m[1], SelectorOperator(m[2]), values...)
+	}
+
+	if i := strings.Index(requirement, "!="); i >= 0 {
+		return newRequirement(requirement[:i], SelectorNotEquals, requirement[i+2:])
+	}
+
+	if i := strings.Index(requirement, "="); i >= 0 {
+		value := strings.TrimPrefix(requirement[i+1:], "=")
+		return newRequirement(requirement[:i], SelectorEquals, value)
+	}
+
+	fields := strings.Fields(requirement)
+	switch {
+	case len(fields) == 1:
+		return newRequirement(fields[0], SelectorExists)
+	case len(fields) == 2 && SelectorOperator(fields[1]) == SelectorExists:
+		return newRequirement(fields[0], SelectorExists)
+	}
+
+	return nil, fmt.Errorf("invalid requirement %q", requirement)
+}
+
+func newRequirement(key string, operator SelectorOperator, values ...string) (*Requirement, error) {
+	key = strings.TrimSpace(key)
+	if key == "" || strings.ContainsAny(key, " \t()") {
+		return nil, fmt.Errorf("invalid tag key %q", key)
+	}
+
+	for i := range values {
+		values[i] = strings.TrimSpace(values[i])
+	}
+
+	if (operator == SelectorIn || operator == SelectorNotIn) && len(values) == 1 && values[0] == "" {
+		return nil, fmt.Errorf("at least one value was expected for %q", key)
+	}
+
+	return &Requirement{
+		Key:      key,
+		Operator: operator,
+		Values:   values,
+	}, nil
+}
+
+// Matches tells whether the tags fulfill the requirement
+func (r Requirement) Matches(tags []ResourceTag) bool {
+	value, ok := "", false
+	for _, tag := range tags {
+		if tag.Key == r.Key {
+			value, ok = tag.Value, true
+			break
+		}
+	}
+
+	switch r.Operator {
+	case SelectorExists:
+		return ok
+	case SelectorEquals, SelectorIn:
+		return ok && r.hasValue(value)
+	case SelectorNotEquals, SelectorNotIn:
+		return !ok || !r.hasValue(value)
+	}
+
+	return false
+}
+
+func (r Requirement) hasValue(value string) bool {
+	for _, v := range r.Values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns the requirement as it may be parsed
+func (r Requirement) String() string {
+	switch r.Operator {
+	case SelectorExists:
+		return r.Key
+	case SelectorIn, SelectorNotIn:
+		return fmt.Sprintf("%s %s (%s)", r.Key, r.Operator, strings.Join(r.Values, ", "))
+	}
+	return r.Key + string(r.Operator) + strings.Join(r.Values, "")
+}
+
+// Matches tells whether the tags fulfill all the requirements
+func (s Selector) Matches(tags []ResourceTag) bool {
+	for _, r := range s {
+		if !r.Matches(tags) {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns the selector as it may be parsed
+func (s Selector) String() string {
+	requirements := make([]string, len(s))
+	for i, r := range s {
+		requirements[i] = r.String()
+	}
+	return strings.Join(requirements, ",")
+}
+
+// selectable represents a resource which may be found by its tags
+type selectable interface {
+	Taggable
+	Gettable
+}
+
+// selectables builds the resources by type, the keys are lowercase as CloudStack doesn't care
+var selectables = map[string]func() selectable{
+	"iso":                func() selectable { return new(ISO) },
+	"loadbalancer":       func() selectable { return new(LoadBalancerRule) },
+	"network":            func() selectable { return new(Network) },
+	"portforwardingrule": func() selectable { return new(PortForwardingRule) },
+	"publicipaddress":    func() selectable { return new(IPAddress) },
+	"securitygroup":      func() selectable { return new(SecurityGroup) },
+	"snapshot":           func() selectable { return new(Snapshot) },
+	"template":           func() selectable { return new(Template) },
+	"user":               func() selectable { return new(User) },
+	"uservm":             func() selectable { return new(VirtualMachine) },
+	"vmsnapshot":         func() selectable { return new(VMSnapshot) },
+	"volume":             func() selectable { return new(Volume) },
+	"zone":               func() selectable { return new(Zone) },
+}
+
+// Select fetches the resources whose tags match the selector, see ParseSelector
+//
+// The resource types are the ones of Taggable, e.g. "UserVM", all of them are
+// searched when none is given. The tags of the keys of the selector are listed
+// to find the resources, a resource without any of them is never selected. The
+// resources of each type are then listed once, the ones removed meanwhile are
+// left out.
+func (client *Client) Select(ctx context.Context, selector string, resourceTypes ...string) ([]Taggable, error) {
+	s, err := ParseSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resourceTypes) == 0 {
+		for resourceType := range selectables {
+			resourceTypes = append(resourceTypes, resourceType)
+		}
+		sort.Strings(resourceTypes)
+	}
+
+	resources := make([]Taggable, 0)
+	for _, resourceType := range resourceTypes {
+		build, ok := selectables[strings.ToLower(resourceType)]
+		if !ok {
+			return nil, fmt.Errorf("the resource type %q cannot be selected", resourceType)
+		}
+
+		ids, err := client.selectIDs(ctx, s, resourceType)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(ids) == 0 {
+			continue
+		}
+
+		matches := make(map[string]bool, len(ids))
+		for _, id := range ids {
+			matches[id] = true
+		}
+
+		items, err := client.ListWithContext(ctx, build())
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range items {
+			resource := item.(Taggable)
+			if matches[resource.ResourceID()] {
+				resources = append(resources, resource)
+			}
+		}
+	}
+
+	return resources, nil
+}
+
+// selectIDs finds the ids of the resources of the given type whose tags match the selector
+//
+// Only the tags of the keys of the selector are listed, all of them without requirements.
+func (client *Client) selectIDs(ctx context.Context, s Selector, resourceType string) ([]string, error) {
+	keys := make([]string, 0, len(s))
+	seen := make(map[string]bool, len(s))
+	for _, r := range s {
+		if !seen[r.Key] {
+			seen[r.Key] = true
+			keys = append(keys, r.Key)
+		}
+	}
+	if len(keys) == 0 {
+		keys = append(keys, "")
+	}
+
+	tags := make(map[string][]ResourceTag)
+	for _, key := range keys {
+		var err error
+		client.PaginateWithContext(ctx, &ListTags{ResourceType: resourceType, Key: key}, func(item interface{}, e error) bool {
+			if e != nil {
+				err = e
+				return false
+			}
+			tag := item.(*ResourceTag)
+			tags[tag.ResourceID] = append(tags[tag.ResourceID], *tag)
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ids := make([]string, 0)
+	for id, t := range tags {
+		if s.Matches(t) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	return ids, nil
+}
@@ -0,0 +1,194 @@
+package egoscale
+
+import (
+	"context"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestParseSelector(t *testing.T) {
+	tests := []struct {
+		selector string
+		expected Selector
+	}{
+		{"", Selector{}},
+		{"env=prod", Selector{{"env", SelectorEquals, []string{"prod"}}}},
+		{"env==prod", Selector{{"env", SelectorEquals, []string{"prod"}}}},
+		{" env != prod ", Selector{{"env", SelectorNotEquals, []string{"prod"}}}},
+		{"env in (staging, prod)", Selector{{"env", SelectorIn, []string{"staging", "prod"}}}},
+		{"env notin (dev)", Selector{{"env", SelectorNotIn, []string{"dev"}}}},
+		{"env in (a=b)", Selector{{"env", SelectorIn, []string{"a=b"}}}},
+		{"backup", Selector{{"backup", SelectorExists, nil}}},
+		{"backup exists", Selector{{"backup", SelectorExists, nil}}},
+		{"env=prod,role in (web, db),backup", Selector{
+			{"env", SelectorEquals, []string{"prod"}},
+			{"role", SelectorIn, []string{"web", "db"}},
+			{"backup", SelectorExists, nil},
+		}},
+	}
+
+	for _, test := range tests {
+		s, err := ParseSelector(test.selector)
+		if err != nil {
+			t.Errorf("%q: %s", test.selector, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(s, test.expected) {
+			t.Errorf("%q: expected %#v, got %#v", test.selector, test.expected, s)
+		}
+	}
+}
+
+func TestParseSelectorFailure(t *testing.T) {
+	selectors := []string{
+		"=prod",
+		"env=prod,",
+		"env in (prod",
+		"env in prod)",
+		"env in prod",
+		"env in ()",
+		"env is prod",
+		"my env=prod",
+	}
+
+	for _, selector := range selectors {
+		if _, err := ParseSelector(selector); err == nil {
+			t.Errorf("%q: an error was expected", selector)
+		}
+	}
+}
+
+func TestSelectorMatches(t *testing.T) {
+	tags := []ResourceTag{
+		{Key: "env", Value: "prod"},
+		{Key: "role", Value: "web"},
+	}
+
+	tests := []struct {
+		selector string
+		matches  bool
+	}{
+		{"", true},
+		{"env=prod", true},
+		{"env=staging", false},
+		{"env!=staging", true},
+		{"backup!=yes", true},
+		{"env in (staging, prod)", true},
+		{"env notin (staging, prod)", false},
+		{"backup notin (yes)", true},
+		{"role", true},
+		{"backup exists", false},
+		{"env=prod,role=web", true},
+		{"env=prod,role=db", false},
+	}
+
+	for _, test := range tests {
+		s, err := ParseSelector(test.selector)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if s.Matches(tags) != test.matches {
+			t.Errorf("%q: expected %v", test.selector, test.matches)
+		}
+	}
+}
+
+func TestSelectorString(t *testing.T) {
+	selector := "env=prod,env!=dev,role in (web, db),role notin (lb),backup"
+
+	s, err := ParseSelector(selector)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if s.String() != selector {
+		t.Errorf("expected %q, got %q", selector, s.String())
+	}
+}
+
+func TestClientSelect(t *testing.T) {
+	queries := make([]url.Values, 0)
+	ts := newRecordingServer(&queries,
+		response{200, jsonContentType, `
+{"listtagsresponse": {
+	"count": 2,
+	"tag": [
+		{"key": "env", "value": "prod", "resourceid": "vm2", "resourcetype": "UserVm"},
+		{"key": "env", "value": "prod", "resourceid": "vm1", "resourcetype": "UserVm"}
+	]
+}}`},
+		response{200, jsonContentType, `
+{"listtagsresponse": {
+	"count": 2,
+	"tag": [
+		{"key": "role", "value": "web", "resourceid": "vm2", "resourcetype": "UserVm"},
+		{"key": "role", "value": "db", "resourceid": "vm1", "resourcetype": "UserVm"}
+	]
+}}`},
+		response{200, jsonContentType, `
+{"listvirtualmachinesresponse": {
+	"count": 2,
+	"virtualmachine": [
+		{"id": "vm1", "name": "db1"},
+		{"id": "vm2", "name": "web1", "tags": [{"key": "env", "value": "prod"}, {"key": "role", "value": "web"}]}
+	]
+}}`},
+	)
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+
+	resources, err := cs.Select(context.TODO(), "env=prod,role in (web)", "UserVM")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(resources) != 1 {
+		t.Fatalf("one resource was expected, got %d", len(resources))
+	}
+
+	vm, ok := resources[0].(*VirtualMachine)
+	if !ok {
+		t.Fatalf("a VirtualMachine was expected, got %T", resources[0])
+	}
+
+	if vm.ID != "vm2" || vm.Name != "web1" {
+		t.Errorf("bad virtual machine, got %#v", vm)
+	}
+
+	if len(queries) != 3 {
+		t.Fatalf("three requests were expected, got %d", len(queries))
+	}
+
+	if queries[2].Get("command") != "listVirtualMachines" || queries[2].Get("id") != "" {
+		t.Errorf("the virtual machines were expected to be listed at once, got %v", queries[2])
+	}
+
+	for i, key := range []string{"env", "role"} {
+		if queries[i].Get("command") != "listTags" || queries[i].Get("key") != key {
+			t.Errorf("the tags of %q were expected to be listed, got %v", key, queries[i])
+		}
+	}
+}
+
+func TestClientSelectFailure(t *testing.T) {
+	ts := newServer()
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+
+	if _, err := cs.Select(context.TODO(), "env in (prod", "UserVM"); err == nil {
+		t.Error("an error was expected")
+	}
+
+	if _, err := cs.Select(context.TODO(), "env=prod", "AffinityGroup"); err == nil {
+		t.Error("an error was expected")
+	}
+
+	if _, err := cs.Select(context.TODO(), "env=prod", "UserVM"); err == nil {
+		t.Error("an error was expected")
+	}
+}
@@ -0,0 +1,27 @@
+package egoscale
+
+// SelectorOperator represents the comparison of a Requirement
+type SelectorOperator string
+
+const (
+	// SelectorEquals requires the tag to have the value
+	SelectorEquals SelectorOperator = "="
+	// SelectorNotEquals requires the tag to be missing or to have another value
+	SelectorNotEquals SelectorOperator = "!="
+	// SelectorIn requires the tag to have one of the values
+	SelectorIn SelectorOperator = "in"
+	// SelectorNotIn requires the tag to be missing or to have none of the values
+	SelectorNotIn SelectorOperator = "notin"
+	// SelectorExists requires the tag to be set, whatever its value
+	SelectorExists SelectorOperator = "exists"
+)
+
+// Requirement represents one condition on a tag of a Selector
+type Requirement struct {
+	Key      string
+	Operator SelectorOperator
+	Values   []string
+}
+
+// Selector represents a set of requirements on the tags of a resource, see ParseSelector
+type Selector []Requirement
@@ -7,8 +7,11 @@ import (
 	"net"
 	"net/url"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 )
 
 func csQuotePlus(s string) string {
@@ -22,158 +25,340 @@ func csEncode(s string) string {
 	return csQuotePlus(url.QueryEscape(s))
 }
 
+// ParamMarshaler is the interface implemented by types that can marshal
+// themselves into a CloudStack parameter value.
+//
+// An empty string omits the parameter.
+type ParamMarshaler interface {
+	MarshalParam() (string, error)
+}
+
+// paramTimeLayout is the date format used by CloudStack
+const paramTimeLayout = "2006-01-02T15:04:05-0700"
+
+var (
+	paramMarshalerType = reflect.TypeOf((*ParamMarshaler)(nil)).Elem()
+	timeType           = reflect.TypeOf(time.Time{})
+	ipType             = reflect.TypeOf(net.IP{})
+	ipNetType          = reflect.TypeOf(net.IPNet{})
+)
+
 // prepareValues uses a command to build a POST request
 //
 // command is not a Command so it's easier to Test
 func prepareValues(prefix string, params *url.Values, command interface{}) error {
 	value := reflect.ValueOf(command)
-	typeof := reflect.TypeOf(command)
 
 	// Going up the pointer chain to find the underlying struct
-	for typeof.Kind() == reflect.Ptr {
-		typeof = typeof.Elem()
+	for value.Kind() == reflect.Ptr {
 		value = value.Elem()
 	}
 
+	for _, field := range structPlanOf(value.Type()).fields {
+		if err := field.encode(prefix+field.name, params, value.Field(field.index)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// paramEncoder encodes one value under the given name
+type paramEncoder func(name string, params *url.Values, val reflect.Value) error
+
+// fieldPlan describes how to encode a struct field
+type fieldPlan struct {
+	index    int
+	name     string
+	required bool
+	encode   paramEncoder
+}
+
+// structPlan holds the encodable fields of a struct type
+type structPlan struct {
+	fields []fieldPlan
+	// byName indexes the fields by their lowercased JSON name
+	byName map[string]int
+}
+
+// field finds a field by its JSON name, like encoding/json the match is case insensitive
+func (plan *structPlan) field(name string) (fieldPlan, bool) {
+	i, ok := plan.byName[strings.ToLower(name)]
+	if !ok {
+		return fieldPlan{}, false
+	}
+	return plan.fields[i], true
+}
+
+// structPlans caches the plans by type, the struct tags are parsed only once
+var structPlans sync.Map
+
+// structPlanOf returns the (cached) plan of the given struct type
+func structPlanOf(typeof reflect.Type) *structPlan {
+	if plan, ok := structPlans.Load(typeof); ok {
+		return plan.(*structPlan)
+	}
+
+	plan, _ := structPlans.LoadOrStore(typeof, newStructPlan(typeof))
+	return plan.(*structPlan)
+}
+
+func newStructPlan(typeof reflect.Type) *structPlan {
+	plan := &structPlan{
+		fields: make([]fieldPlan, 0, typeof.NumField()),
+		byName: make(map[string]int, typeof.NumField()),
+	}
+
 	for i := 0; i < typeof.NumField(); i++ {
 		field := typeof.Field(i)
-		val := value.Field(i)
-		tag := field.Tag
-		if json, ok := tag.Lookup("json"); ok {
-			n, required := ExtractJSONTag(field.Name, json)
-			name := prefix + n
-
-			switch val.Kind() {
-			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-				v := val.Int()
-				if v == 0 {
-					if required {
-						return fmt.Errorf("%s.%s (%v) is required, got 0", typeof.Name(), n, val.Kind())
-					}
-				} else {
-					(*params).Set(name, strconv.FormatInt(v, 10))
-				}
-			case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-				v := val.Uint()
-				if v == 0 {
-					if required {
-						return fmt.Errorf("%s.%s (%v) is required, got 0", typeof.Name(), n, val.Kind())
-					}
-				} else {
-					(*params).Set(name, strconv.FormatUint(v, 10))
-				}
-			case reflect.Float32, reflect.Float64:
-				v := val.Float()
-				if v == 0 {
-					if required {
-						return fmt.Errorf("%s.%s (%v) is required, got 0", typeof.Name(), n, val.Kind())
-					}
-				} else {
-					(*params).Set(name, strconv.FormatFloat(v, 'f', -1, 64))
-				}
-			case reflect.String:
-				v := val.String()
-				if v == "" {
-					if required {
-						return fmt.Errorf("%s.%s (%v) is required, got \"\"", typeof.Name(), n, val.Kind())
-					}
-				} else {
-					(*params).Set(name, v)
-				}
-			case reflect.Bool:
-				v := val.Bool()
-				if !v {
-					if required {
-						params.Set(name, "false")
-					}
-				} else {
-					(*params).Set(name, "true")
-				}
-			case reflect.Ptr:
-				if val.IsNil() {
-					if required {
-						return fmt.Errorf("%s.%s (%v) is required, got tempty ptr", typeof.Name(), n, val.Kind())
-					}
-				} else {
-					switch field.Type.Elem().Kind() {
-					case reflect.Bool:
-						params.Set(name, strconv.FormatBool(val.Elem().Bool()))
-					default:
-						log.Printf("[SKIP] %s.%s (%v) not supported", typeof.Name(), n, field.Type.Elem().Kind())
-					}
-				}
-			case reflect.Slice:
-				switch field.Type.Elem().Kind() {
-				case reflect.Uint8:
-					switch field.Type {
-					case reflect.TypeOf(net.IPv4zero):
-						ip := (net.IP)(val.Bytes())
-						if ip == nil || ip.Equal(net.IPv4zero) {
-							if required {
-								return fmt.Errorf("%s.%s (%v) is required, got zero IPv4 address", typeof.Name(), n, val.Kind())
-							}
-						} else {
-							(*params).Set(name, ip.String())
-						}
-					default:
-						if val.Len() == 0 {
-							if required {
-								return fmt.Errorf("%s.%s (%v) is required, got empty slice", typeof.Name(), n, val.Kind())
-							}
-						} else {
-							v := val.Bytes()
-							(*params).Set(name, base64.StdEncoding.EncodeToString(v))
-						}
-					}
-				case reflect.String:
-					{
-						if val.Len() == 0 {
-							if required {
-								return fmt.Errorf("%s.%s (%v) is required, got empty slice", typeof.Name(), n, val.Kind())
-							}
-						} else {
-							elems := make([]string, 0, val.Len())
-							for i := 0; i < val.Len(); i++ {
-								// XXX what if the value contains a comma? Double encode?
-								s := val.Index(i).String()
-								elems = append(elems, s)
-							}
-							(*params).Set(name, strings.Join(elems, ","))
-						}
-					}
-				default:
-					if val.Len() == 0 {
-						if required {
-							return fmt.Errorf("%s.%s (%v) is required, got empty slice", typeof.Name(), n, val.Kind())
-						}
-					} else {
-						err := prepareList(name, params, val.Interface())
-						if err != nil {
-							return err
-						}
-					}
+		json, ok := field.Tag.Lookup("json")
+		if !ok {
+			log.Printf("[SKIP] %s.%s no json label found", typeof.Name(), field.Name)
+			continue
+		}
+
+		n, required := ExtractJSONTag(field.Name, json)
+		fieldName := fmt.Sprintf("%s.%s", typeof.Name(), n)
+
+		encode := newParamEncoder(field.Type, fieldName, required)
+		if field.Type.Kind() == reflect.Map && field.Tag.Get("map") == "keyvalue" {
+			encode = newKeyValueEncoder(fieldName, required)
+		}
+
+		plan.byName[strings.ToLower(n)] = len(plan.fields)
+		plan.fields = append(plan.fields, fieldPlan{
+			index:    i,
+			name:     n,
+			required: required,
+			encode:   encode,
+		})
+	}
+
+	return plan
+}
+
+// newParamEncoder picks a dedicated encoder for the most common types
+func newParamEncoder(typeof reflect.Type, fieldName string, required bool) paramEncoder {
+	generic := func(name string, params *url.Values, val reflect.Value) error {
+		return prepareValue(name, fieldName, params, val, required, false)
+	}
+
+	if typeof.Implements(paramMarshalerType) || reflect.PtrTo(typeof).Implements(paramMarshalerType) {
+		return generic
+	}
+
+	switch typeof.Kind() {
+	case reflect.String:
+		return func(name string, params *url.Values, val reflect.Value) error {
+			v := val.String()
+			if v == "" {
+				if required {
+					return fmt.Errorf("%s (%v) is required, got \"\"", fieldName, val.Kind())
 				}
-			case reflect.Map:
-				if val.Len() == 0 {
-					if required {
-						return fmt.Errorf("%s.%s (%v) is required, got empty map", typeof.Name(), field.Name, val.Kind())
-					}
-				} else {
-					err := prepareMap(name, params, val.Interface())
-					if err != nil {
-						return err
-					}
+				return nil
+			}
+			params.Set(name, v)
+			return nil
+		}
+	case reflect.Bool:
+		return func(name string, params *url.Values, val reflect.Value) error {
+			if val.Bool() {
+				params.Set(name, "true")
+			} else if required {
+				params.Set(name, "false")
+			}
+			return nil
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(name string, params *url.Values, val reflect.Value) error {
+			v := val.Int()
+			if v == 0 {
+				if required {
+					return fmt.Errorf("%s (%v) is required, got 0", fieldName, val.Kind())
 				}
-			default:
+				return nil
+			}
+			params.Set(name, strconv.FormatInt(v, 10))
+			return nil
+		}
+	case reflect.Uint, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return func(name string, params *url.Values, val reflect.Value) error {
+			v := val.Uint()
+			if v == 0 {
 				if required {
-					return fmt.Errorf("unsupported type %s.%s (%v)", typeof.Name(), n, val.Kind())
+					return fmt.Errorf("%s (%v) is required, got 0", fieldName, val.Kind())
 				}
+				return nil
 			}
-		} else {
-			log.Printf("[SKIP] %s.%s no json label found", typeof.Name(), field.Name)
+			params.Set(name, strconv.FormatUint(v, 10))
+			return nil
+		}
+	}
+
+	return generic
+}
+
+// newKeyValueEncoder encodes the map entries as key and value pairs, see prepareMap
+func newKeyValueEncoder(fieldName string, required bool) paramEncoder {
+	return func(name string, params *url.Values, val reflect.Value) error {
+		if val.Len() == 0 {
+			if required {
+				return fmt.Errorf("%s (%v) is required, got empty map", fieldName, val.Kind())
+			}
+			return nil
 		}
+		return prepareMap(name, params, val.Interface(), true)
+	}
+}
+
+// prepareValue encodes one value under the given name
+//
+// explicit values are sent even when empty, e.g. a non-nil pointer to false or 0.
+func prepareValue(name, fieldName string, params *url.Values, val reflect.Value, required, explicit bool) error {
+	if val.Kind() == reflect.Ptr || val.Kind() == reflect.Interface {
+		if val.IsNil() {
+			if required {
+				return fmt.Errorf("%s (%v) is required, got empty ptr", fieldName, val.Kind())
+			}
+			return nil
+		}
+
+		if val.Type().Implements(paramMarshalerType) {
+			return prepareMarshaler(name, fieldName, params, val, required)
+		}
+
+		return prepareValue(name, fieldName, params, val.Elem(), required, true)
+	}
+
+	if val.Type().Implements(paramMarshalerType) {
+		return prepareMarshaler(name, fieldName, params, val, required)
+	}
+
+	if val.CanAddr() && val.Addr().Type().Implements(paramMarshalerType) {
+		return prepareMarshaler(name, fieldName, params, val.Addr(), required)
+	}
+
+	if isEmptyParam(val) && !explicit {
+		if !required {
+			return nil
+		}
+		// booleans are always sent when required
+		if val.Kind() == reflect.Bool {
+			params.Set(name, "false")
+			return nil
+		}
+		return fmt.Errorf("%s (%v) is required, got empty %s", fieldName, val.Kind(), val.Type())
+	}
+
+	if v, ok := paramString(val); ok {
+		params.Set(name, v)
+		return nil
+	}
+
+	switch val.Kind() {
+	case reflect.Struct:
+		// the required fields of an omitted struct aren't
+		if !required && reflect.DeepEqual(val.Interface(), reflect.Zero(val.Type()).Interface()) {
+			return nil
+		}
+		return prepareValues(name+"[0].", params, val.Interface())
+	case reflect.Slice:
+		return prepareSlice(name, fieldName, params, val)
+	case reflect.Map:
+		return prepareMap(name, params, val.Interface(), false)
+	}
+
+	if required {
+		return fmt.Errorf("unsupported type %s (%v)", fieldName, val.Kind())
+	}
+	log.Printf("[SKIP] %s (%v) not supported", fieldName, val.Kind())
+	return nil
+}
+
+// prepareMarshaler encodes a ParamMarshaler
+func prepareMarshaler(name, fieldName string, params *url.Values, val reflect.Value, required bool) error {
+	v, err := val.Interface().(ParamMarshaler).MarshalParam()
+	if err != nil {
+		return fmt.Errorf("%s: %s", fieldName, err)
 	}
 
+	if v == "" {
+		if required {
+			return fmt.Errorf("%s (%v) is required, got \"\"", fieldName, val.Type())
+		}
+		return nil
+	}
+
+	params.Set(name, v)
+	return nil
+}
+
+// paramString encodes the scalar values, false is returned for the composite ones
+func paramString(val reflect.Value) (string, bool) {
+	switch val.Type() {
+	case timeType:
+		return val.Interface().(time.Time).Format(paramTimeLayout), true
+	case ipType:
+		return (net.IP)(val.Bytes()).String(), true
+	case ipNetType:
+		ipNet := val.Interface().(net.IPNet)
+		return ipNet.String(), true
+	}
+
+	switch val.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(val.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(val.Uint(), 10), true
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(val.Float(), 'f', -1, 64), true
+	case reflect.String:
+		return val.String(), true
+	case reflect.Bool:
+		return strconv.FormatBool(val.Bool()), true
+	case reflect.Slice:
+		if val.Type().Elem().Kind() == reflect.Uint8 {
+			return base64.StdEncoding.EncodeToString(val.Bytes()), true
+		}
+	}
+
+	return "", false
+}
+
+// prepareSlice encodes a list of scalars as comma separated values or a list of structs
+func prepareSlice(name, fieldName string, params *url.Values, val reflect.Value) error {
+	for i := 0; i < val.Len(); i++ {
+		elem := val.Index(i)
+		if (elem.Kind() == reflect.Ptr || elem.Kind() == reflect.Interface) && elem.IsNil() {
+			return fmt.Errorf("%s[%d] (%v) is nil", fieldName, i, elem.Kind())
+		}
+	}
+
+	elems := make([]string, 0, val.Len())
+	for i := 0; i < val.Len(); i++ {
+		elem := val.Index(i)
+		for elem.Kind() == reflect.Ptr && !elem.IsNil() && !elem.Type().Implements(paramMarshalerType) {
+			elem = elem.Elem()
+		}
+
+		if elem.Type().Implements(paramMarshalerType) {
+			// XXX what if the value contains a comma? Double encode?
+			s, err := elem.Interface().(ParamMarshaler).MarshalParam()
+			if err != nil {
+				return fmt.Errorf("%s[%d]: %s", fieldName, i, err)
+			}
+			elems = append(elems, s)
+			continue
+		}
+
+		s, ok := paramString(elem)
+		if !ok {
+			return prepareList(name, params, val.Interface())
+		}
+		elems = append(elems, s)
+	}
+
+	params.Set(name, strings.Join(elems, ","))
 	return nil
 }
 
@@ -190,32 +375,71 @@ func prepareList(prefix string, params *url.Values, slice interface{}) error {
 	return nil
 }
 
-func prepareMap(prefix string, params *url.Values, m interface{}) error {
+// prepareMap encodes the map entries, sorted by key so the payload is reproducible
+//
+// By default an entry is sent as prefix[i].name=value, with keyValue it is sent
+// as prefix[i].key=name and prefix[i].value=value like the tags.
+func prepareMap(prefix string, params *url.Values, m interface{}, keyValue bool) error {
 	value := reflect.ValueOf(m)
 
-	for i, key := range value.MapKeys() {
-		var keyName string
-		var keyValue string
-
-		switch key.Kind() {
-		case reflect.String:
-			keyName = key.String()
-		default:
+	keys := value.MapKeys()
+	for _, key := range keys {
+		if key.Kind() != reflect.String {
 			return fmt.Errorf("only map[string]string are supported (XXX)")
 		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
 
+	for i, key := range keys {
 		val := value.MapIndex(key)
+		var entryValue string
 		switch val.Kind() {
 		case reflect.String:
-			keyValue = val.String()
+			entryValue = val.String()
 		default:
 			return fmt.Errorf("only map[string]string are supported (XXX)")
 		}
-		params.Set(fmt.Sprintf("%s[%d].%s", prefix, i, keyName), keyValue)
+		if keyValue {
+			params.Set(fmt.Sprintf("%s[%d].key", prefix, i), key.String())
+			params.Set(fmt.Sprintf("%s[%d].value", prefix, i), entryValue)
+			continue
+		}
+		params.Set(fmt.Sprintf("%s[%d].%s", prefix, i, key.String()), entryValue)
 	}
 	return nil
 }
 
+// isEmptyParam tells whether the value would be omitted from the request
+func isEmptyParam(val reflect.Value) bool {
+	switch val.Type() {
+	case timeType:
+		return val.Interface().(time.Time).IsZero()
+	case ipType:
+		ip := (net.IP)(val.Bytes())
+		return ip == nil || ip.Equal(net.IPv4zero)
+	case ipNetType:
+		return val.Field(0).Len() == 0
+	}
+
+	switch val.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return val.IsNil()
+	case reflect.Slice, reflect.Map, reflect.String:
+		return val.Len() == 0
+	case reflect.Bool:
+		return !val.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return val.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return val.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return val.Float() == 0
+	}
+	return false
+}
+
 // ExtractJSONTag returns the variable name or defaultName as well as if the field is required (!omitempty)
 func ExtractJSONTag(defaultName, jsonTag string) (string, bool) {
 	tags := strings.Split(jsonTag, ",")
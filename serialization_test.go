@@ -1,9 +1,13 @@
 package egoscale
 
 import (
+	"fmt"
 	"net"
 	"net/url"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestPrepareValues(t *testing.T) {
@@ -286,3 +290,263 @@ func TestPrepareValuesBoolPtr(t *testing.T) {
 		t.Errorf("Expected is_five to be missing, got %v", isFive)
 	}
 }
+
+type testParam string
+
+func (p testParam) MarshalParam() (string, error) {
+	return strings.ToUpper(string(p)), nil
+}
+
+func TestPrepareValuesExtended(t *testing.T) {
+	type inner struct {
+		Name string `json:"name"`
+	}
+
+	zero := 0
+	answer := 42
+	_, cidr, _ := net.ParseCIDR("192.168.0.0/24")
+	date := time.Date(2018, time.March, 23, 0, 41, 14, 0, time.FixedZone("CET", 3600))
+
+	profile := struct {
+		Zero   *int        `json:"zero,omitempty"`
+		Answer *int        `json:"answer,omitempty"`
+		Nil    *int        `json:"nil,omitempty"`
+		Date   time.Time   `json:"date,omitempty"`
+		Never  time.Time   `json:"never,omitempty"`
+		Cidr   net.IPNet   `json:"cidr,omitempty"`
+		Inner  inner       `json:"inner,omitempty"`
+		Param  testParam   `json:"param,omitempty"`
+		Params []testParam `json:"params,omitempty"`
+		Ports  []int       `json:"ports,omitempty"`
+	}{
+		Zero:   &zero,
+		Answer: &answer,
+		Date:   date,
+		Cidr:   *cidr,
+		Inner:  inner{Name: "foo"},
+		Param:  "bar",
+		Params: []testParam{"a", "b"},
+		Ports:  []int{22, 80},
+	}
+
+	params := url.Values{}
+	err := prepareValues("", &params, &profile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]string{
+		"zero":          "0",
+		"answer":        "42",
+		"date":          "2018-03-23T00:41:14+0100",
+		"cidr":          "192.168.0.0/24",
+		"inner[0].name": "foo",
+		"param":         "BAR",
+		"params":        "A,B",
+		"ports":         "22,80",
+	}
+
+	for k, v := range expected {
+		if params.Get(k) != v {
+			t.Errorf("%s: expected %q, got %q", k, v, params.Get(k))
+		}
+	}
+
+	for _, k := range []string{"nil", "never"} {
+		if _, ok := params[k]; ok {
+			t.Errorf("%s shouldn't be set, got %q", k, params.Get(k))
+		}
+	}
+}
+
+func TestPrepareValuesNestedRequired(t *testing.T) {
+	type inner struct {
+		Name string `json:"name"`
+	}
+
+	profile := struct {
+		Inner inner `json:"inner"`
+	}{}
+
+	params := url.Values{}
+	err := prepareValues("", &params, &profile)
+	if err == nil {
+		t.Errorf("It should have failed")
+	}
+}
+
+func TestPrepareValuesNestedOptional(t *testing.T) {
+	type inner struct {
+		Name string `json:"name"`
+	}
+
+	profile := struct {
+		Inner inner `json:"inner,omitempty"`
+	}{}
+
+	params := url.Values{}
+	if err := prepareValues("", &params, &profile); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(params) != 0 {
+		t.Errorf("no params were expected, got %v", params)
+	}
+
+	profile.Inner.Name = "foo"
+	if err := prepareValues("", &params, &profile); err != nil {
+		t.Fatal(err)
+	}
+
+	if params.Get("inner[0].name") != "foo" {
+		t.Errorf("inner[0].name doesn't match, got %v", params)
+	}
+}
+
+func TestPrepareValuesSliceNil(t *testing.T) {
+	type inner struct {
+		Name string `json:"name"`
+	}
+
+	profile := struct {
+		Inners []*inner `json:"inners,omitempty"`
+		Ports  []*int   `json:"ports,omitempty"`
+	}{
+		Inners: []*inner{{Name: "foo"}, nil},
+	}
+
+	params := url.Values{}
+	if err := prepareValues("", &params, &profile); err == nil {
+		t.Errorf("an error was expected, inners[1] is nil")
+	}
+
+	profile.Inners = nil
+	profile.Ports = []*int{nil}
+	if err := prepareValues("", &params, &profile); err == nil {
+		t.Errorf("an error was expected, ports[0] is nil")
+	}
+}
+
+func TestPrepareValuesMapOrder(t *testing.T) {
+	profile := struct {
+		Map map[string]string `json:"map"`
+	}{
+		Map: map[string]string{
+			"d": "4",
+			"b": "2",
+			"a": "1",
+			"c": "3",
+		},
+	}
+
+	for i := 0; i < 10; i++ {
+		params := url.Values{}
+		if err := prepareValues("", &params, &profile); err != nil {
+			t.Fatal(err)
+		}
+
+		for j, k := range []string{"a", "b", "c", "d"} {
+			key := fmt.Sprintf("map[%d].%s", j, k)
+			if _, ok := params[key]; !ok {
+				t.Fatalf("%s was expected, got %v", key, params)
+			}
+		}
+	}
+}
+
+func TestPrepareValuesMapKeyValue(t *testing.T) {
+	profile := struct {
+		Map      map[string]string `json:"map" map:"keyvalue"`
+		Required map[string]string `json:"required" map:"keyvalue"`
+	}{
+		Map: map[string]string{
+			"b": "2",
+			"a": "1",
+		},
+	}
+
+	params := url.Values{}
+	if err := prepareValues("", &params, &profile); err == nil {
+		t.Errorf("an error was expected, required is empty")
+	}
+
+	profile.Required = map[string]string{"c": "3"}
+	params = url.Values{}
+	if err := prepareValues("", &params, &profile); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := url.Values{
+		"map[0].key":        {"a"},
+		"map[0].value":      {"1"},
+		"map[1].key":        {"b"},
+		"map[1].value":      {"2"},
+		"required[0].key":   {"c"},
+		"required[0].value": {"3"},
+	}
+
+	if !reflect.DeepEqual(params, expected) {
+		t.Errorf("expected %v, got %v", expected, params)
+	}
+}
+
+func benchmarkCommand() *DeployVirtualMachine {
+	return &DeployVirtualMachine{
+		DisplayName:       "benchmark",
+		KeyPair:           "default",
+		Name:              "benchmark",
+		RootDiskSize:      50,
+		SecurityGroupIDs:  []string{"5f3fc7b3-a3c3-4d05-a3b0-e2be7b8dd5d1", "3d2b8c5a-91c4-4e8c-b95c-b1f28e0f4f3e"},
+		ServiceOfferingID: "71004023-bb72-4a97-b1e9-bc66dfce9470",
+		TemplateID:        "78c2cbe6-8e11-4722-b01f-bf06f4e28108",
+		ZoneID:            "1128bd56-b4d9-4ac6-a7b9-c715b187ce11",
+		Details: map[string]string{
+			"foo": "bar",
+		},
+	}
+}
+
+func BenchmarkPrepareValues(b *testing.B) {
+	req := benchmarkCommand()
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		params := url.Values{}
+		if err := prepareValues("", &params, req); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkPrepareValuesWithoutCache(b *testing.B) {
+	req := benchmarkCommand()
+	typeof := reflect.TypeOf(*req)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		structPlans.Delete(typeof)
+		params := url.Values{}
+		if err := prepareValues("", &params, req); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkPayload(b *testing.B) {
+	cs := NewClient("https://api.exoscale.ch/compute", "KEY", "SECRET")
+	req := &CreateTags{
+		ResourceIDs:  []string{"9ccc3d5b-9dce-4302-a955-24b80b402f88"},
+		ResourceType: "UserVM",
+		Tags: []ResourceTag{
+			{Key: "env", Value: "prod"},
+			{Key: "role", Value: "db"},
+		},
+	}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := cs.Payload(req); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -1,10 +1,78 @@
 package egoscale
 
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
 // ResourceType returns the type of the resource
 func (*Snapshot) ResourceType() string {
 	return "Snapshot"
 }
 
+// ResourceID returns the identifier of the resource
+func (snapshot *Snapshot) ResourceID() string {
+	return snapshot.ID
+}
+
+// Delete removes the given snapshot
+func (snapshot *Snapshot) Delete(ctx context.Context, client *Client) error {
+	if snapshot.ID == "" {
+		return fmt.Errorf("a Snapshot may only be deleted using ID")
+	}
+
+	return client.BooleanRequestWithContext(ctx, &DeleteSnapshot{
+		ID: snapshot.ID,
+	})
+}
+
+// ListRequest builds the ListSnapshots request
+func (snapshot *Snapshot) ListRequest() (ListCommand, error) {
+	req := &ListSnapshots{
+		Account:  snapshot.Account,
+		DomainID: snapshot.DomainID,
+		ID:       snapshot.ID,
+		Name:     snapshot.Name,
+		Tags:     tagFilter(snapshot.Tags),
+		VolumeID: snapshot.VolumeID,
+		ZoneID:   snapshot.ZoneID,
+	}
+
+	return req, nil
+}
+
+// UnmarshalJSON accepts the state by name, as sent by CloudStack, or by value
+func (state *SnapshotState) UnmarshalJSON(b []byte) error {
+	var name string
+	if err := json.Unmarshal(b, &name); err != nil {
+		var value int
+		if err := json.Unmarshal(b, &value); err != nil {
+			return fmt.Errorf("invalid SnapshotState, got %s", b)
+		}
+		*state = SnapshotState(value)
+		return nil
+	}
+
+	for i := Allocated; i <= Error; i++ {
+		if i.String() == name {
+			*state = i
+			return nil
+		}
+	}
+
+	if value, err := strconv.Atoi(name); err == nil {
+		*state = SnapshotState(value)
+		return nil
+	}
+
+	return fmt.Errorf("unknown SnapshotState, got %q", name)
+}
+
 func (*CreateSnapshot) name() string {
 	return "createSnapshot"
 }
@@ -29,6 +97,30 @@ func (*ListSnapshots) response() interface{} {
 	return new(ListSnapshotsResponse)
 }
 
+// SetPage sets the current page
+func (ls *ListSnapshots) SetPage(page int) {
+	ls.Page = page
+}
+
+// SetPageSize sets the page size
+func (ls *ListSnapshots) SetPageSize(pageSize int) {
+	ls.PageSize = pageSize
+}
+
+func (*ListSnapshots) each(resp interface{}, callback IterateItemFunc) {
+	snapshots, ok := resp.(*ListSnapshotsResponse)
+	if !ok {
+		callback(nil, fmt.Errorf("wrong type. ListSnapshotsResponse expected, got %T", resp))
+		return
+	}
+
+	for i := range snapshots.Snapshot {
+		if !callback(&snapshots.Snapshot[i], nil) {
+			break
+		}
+	}
+}
+
 func (*DeleteSnapshot) name() string {
 	return "deleteSnapshot"
 }
@@ -52,3 +144,239 @@ func (*RevertSnapshot) description() string {
 func (*RevertSnapshot) asyncResponse() interface{} {
 	return new(booleanResponse)
 }
+
+func (*CreateSnapshotPolicy) name() string {
+	return "createSnapshotPolicy"
+}
+
+func (*CreateSnapshotPolicy) description() string {
+	return "Creates a snapshot policy for the account."
+}
+
+func (*CreateSnapshotPolicy) response() interface{} {
+	return new(SnapshotPolicy)
+}
+
+func (*UpdateSnapshotPolicy) name() string {
+	return "updateSnapshotPolicy"
+}
+
+func (*UpdateSnapshotPolicy) description() string {
+	return "Updates the snapshot policy."
+}
+
+func (*UpdateSnapshotPolicy) asyncResponse() interface{} {
+	return new(SnapshotPolicy)
+}
+
+func (*ListSnapshotPolicies) name() string {
+	return "listSnapshotPolicies"
+}
+
+func (*ListSnapshotPolicies) description() string {
+	return "Lists snapshot policies."
+}
+
+func (*ListSnapshotPolicies) response() interface{} {
+	return new(ListSnapshotPoliciesResponse)
+}
+
+// SetPage sets the current page
+func (ls *ListSnapshotPolicies) SetPage(page int) {
+	ls.Page = page
+}
+
+// SetPageSize sets the page size
+func (ls *ListSnapshotPolicies) SetPageSize(pageSize int) {
+	ls.PageSize = pageSize
+}
+
+func (*ListSnapshotPolicies) each(resp interface{}, callback IterateItemFunc) {
+	policies, ok := resp.(*ListSnapshotPoliciesResponse)
+	if !ok {
+		callback(nil, fmt.Errorf("wrong type. ListSnapshotPoliciesResponse expected, got %T", resp))
+		return
+	}
+
+	for i := range policies.SnapshotPolicy {
+		if !callback(&policies.SnapshotPolicy[i], nil) {
+			break
+		}
+	}
+}
+
+func (*DeleteSnapshotPolicies) name() string {
+	return "deleteSnapshotPolicies"
+}
+
+func (*DeleteSnapshotPolicies) description() string {
+	return "Deletes snapshot policies for the account."
+}
+
+func (*DeleteSnapshotPolicies) response() interface{} {
+	return new(booleanResponse)
+}
+
+// ExpiredSnapshots returns the snapshots which are not retained by the policies
+//
+// Only the recurring snapshots, the ones created by a policy, may expire, the
+// manual ones are never expired. Each policy keeps the most recent snapshot of
+// its last MaxSnaps periods (hours, days, weeks or months) among the snapshots
+// of its interval type, a snapshot kept by any policy is not expired. The ones
+// not yet backed up, or of an interval type without any policy keeping some,
+// are never expired. At least one policy must keep some snapshots, it'd expire
+// them all otherwise.
+func ExpiredSnapshots(policies []SnapshotPolicy, snapshots []Snapshot) ([]Snapshot, error) {
+	periods := make(map[IntervalType][]snapshotPeriod, len(policies))
+	for _, policy := range policies {
+		if policy.MaxSnaps < 0 {
+			return nil, fmt.Errorf("snapshot policy %s has an invalid maxsnaps %d", policy.ID, policy.MaxSnaps)
+		}
+		if policy.MaxSnaps == 0 {
+			continue
+		}
+
+		period, ok := snapshotPeriods[policy.IntervalType]
+		if !ok {
+			return nil, fmt.Errorf("snapshot policy %s has an unsupported interval type %d", policy.ID, policy.IntervalType)
+		}
+		periods[policy.IntervalType] = append(periods[policy.IntervalType], snapshotPeriod{policy.MaxSnaps, period})
+	}
+
+	if len(periods) == 0 {
+		return nil, fmt.Errorf("a retention requires at least one snapshot policy keeping snapshots")
+	}
+
+	type dated struct {
+		snapshot Snapshot
+		created  time.Time
+	}
+
+	candidates := make(map[IntervalType][]dated, len(periods))
+	for _, snapshot := range snapshots {
+		if snapshot.State != BackedUp || !strings.EqualFold(snapshot.SnapshotType, "RECURRING") {
+			continue
+		}
+
+		intervalType, ok := snapshotIntervalTypes[strings.ToUpper(snapshot.IntervalType)]
+		if !ok || len(periods[intervalType]) == 0 {
+			continue
+		}
+
+		created, err := time.Parse(paramTimeLayout, snapshot.Created)
+		if err != nil {
+			return nil, fmt.Errorf("snapshot %s has an invalid creation date: %s", snapshot.ID, err)
+		}
+		candidates[intervalType] = append(candidates[intervalType], dated{snapshot, created})
+	}
+
+	expired := make([]Snapshot, 0)
+	for _, intervalType := range []IntervalType{HourlyInterval, DailyInterval, WeeklyInterval, MonthlyInterval} {
+		dates := candidates[intervalType]
+
+		// most recent first
+		sort.SliceStable(dates, func(i, j int) bool {
+			return dates[i].created.After(dates[j].created)
+		})
+
+		kept := make(map[int]bool, len(dates))
+		for _, p := range periods[intervalType] {
+			seen := make(map[string]bool, p.keep)
+			for i, c := range dates {
+				if len(seen) >= p.keep {
+					break
+				}
+
+				key := p.period(c.created)
+				if !seen[key] {
+					seen[key] = true
+					kept[i] = true
+				}
+			}
+		}
+
+		for i, c := range dates {
+			if !kept[i] {
+				expired = append(expired, c.snapshot)
+			}
+		}
+	}
+
+	return expired, nil
+}
+
+// snapshotPeriod keeps the most recent snapshot of the last periods
+type snapshotPeriod struct {
+	keep   int
+	period func(time.Time) string
+}
+
+// snapshotIntervalTypes reads the interval type of a recurring snapshot
+var snapshotIntervalTypes = map[string]IntervalType{
+	"HOURLY":  HourlyInterval,
+	"DAILY":   DailyInterval,
+	"WEEKLY":  WeeklyInterval,
+	"MONTHLY": MonthlyInterval,
+}
+
+// snapshotPeriods names the period of a snapshot per interval type
+var snapshotPeriods = map[IntervalType]func(time.Time) string{
+	HourlyInterval: func(t time.Time) string { return t.Format("2006-01-02T15") },
+	DailyInterval:  func(t time.Time) string { return t.Format("2006-01-02") },
+	WeeklyInterval: func(t time.Time) string {
+		year, week := t.ISOWeek()
+		return fmt.Sprintf("%d-W%02d", year, week)
+	},
+	MonthlyInterval: func(t time.Time) string { return t.Format("2006-01") },
+}
+
+// ApplySnapshotRetention deletes the expired snapshots of the given volume and returns them
+//
+// Without policies, the snapshot policies of the volume are used, e.g. keep 7
+// daily and 4 weekly snapshots. The manual snapshots are never deleted, nor is
+// anything if no policy keeps any snapshot, see ExpiredSnapshots.
+func (client *Client) ApplySnapshotRetention(ctx context.Context, volumeID string, policies ...SnapshotPolicy) ([]Snapshot, error) {
+	var err error
+
+	if len(policies) == 0 {
+		client.PaginateWithContext(ctx, &ListSnapshotPolicies{VolumeID: volumeID}, func(item interface{}, e error) bool {
+			if e != nil {
+				err = e
+				return false
+			}
+			policies = append(policies, *item.(*SnapshotPolicy))
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	snapshots := make([]Snapshot, 0)
+	client.PaginateWithContext(ctx, &ListSnapshots{VolumeID: volumeID}, func(item interface{}, e error) bool {
+		if e != nil {
+			err = e
+			return false
+		}
+		snapshots = append(snapshots, *item.(*Snapshot))
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	expired, err := ExpiredSnapshots(policies, snapshots)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted := make([]Snapshot, 0, len(expired))
+	for _, snapshot := range expired {
+		if err := client.BooleanRequestWithContext(ctx, &DeleteSnapshot{ID: snapshot.ID}); err != nil {
+			return deleted, err
+		}
+		deleted = append(deleted, snapshot)
+	}
+
+	return deleted, nil
+}
@@ -1,6 +1,11 @@
 package egoscale
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
 	"testing"
 )
 
@@ -42,3 +47,308 @@ func TestRevertSnapshot(t *testing.T) {
 	}
 	_ = req.asyncResponse().(*booleanResponse)
 }
+
+func TestCreateSnapshotPolicy(t *testing.T) {
+	req := &CreateSnapshotPolicy{}
+	if req.name() != "createSnapshotPolicy" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.response().(*SnapshotPolicy)
+}
+
+func TestUpdateSnapshotPolicy(t *testing.T) {
+	req := &UpdateSnapshotPolicy{}
+	if req.name() != "updateSnapshotPolicy" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.asyncResponse().(*SnapshotPolicy)
+}
+
+func TestListSnapshotPolicies(t *testing.T) {
+	req := &ListSnapshotPolicies{}
+	if req.name() != "listSnapshotPolicies" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.response().(*ListSnapshotPoliciesResponse)
+}
+
+func TestDeleteSnapshotPolicies(t *testing.T) {
+	req := &DeleteSnapshotPolicies{}
+	if req.name() != "deleteSnapshotPolicies" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.response().(*booleanResponse)
+}
+
+func TestSnapshotStateUnmarshal(t *testing.T) {
+	var snapshot Snapshot
+	if err := json.Unmarshal([]byte(`{"state": "BackedUp"}`), &snapshot); err != nil {
+		t.Fatal(err)
+	}
+	if snapshot.State != BackedUp {
+		t.Errorf("BackedUp state was expected, got %s", snapshot.State)
+	}
+
+	if err := json.Unmarshal([]byte(`{"state": 8}`), &snapshot); err != nil {
+		t.Fatal(err)
+	}
+	if snapshot.State != Error {
+		t.Errorf("Error state was expected, got %s", snapshot.State)
+	}
+
+	if err := json.Unmarshal([]byte(`{"state": "Unknown"}`), &snapshot); err == nil {
+		t.Error("an error was expected")
+	}
+}
+
+func TestSnapshotRetentionExpired(t *testing.T) {
+	snapshots := make([]Snapshot, 0)
+	for day := 1; day <= 10; day++ {
+		snapshots = append(snapshots, Snapshot{
+			ID:           fmt.Sprintf("%d", day),
+			Created:      fmt.Sprintf("2018-05-%02dT12:00:00+0200", day),
+			IntervalType: "DAILY",
+			SnapshotType: "RECURRING",
+			State:        BackedUp,
+		})
+	}
+	// not backed up yet
+	snapshots = append(snapshots, Snapshot{
+		ID:           "11",
+		Created:      "2018-05-11T12:00:00+0200",
+		IntervalType: "DAILY",
+		SnapshotType: "RECURRING",
+		State:        BackingUp,
+	})
+
+	policies := []SnapshotPolicy{
+		{IntervalType: DailyInterval, MaxSnaps: 3},
+	}
+	expired, err := ExpiredSnapshots(policies, snapshots)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ids := make([]string, len(expired))
+	for i, snapshot := range expired {
+		ids[i] = snapshot.ID
+	}
+
+	// 10, 9 and 8 are the last days
+	if strings.Join(ids, ",") != "7,6,5,4,3,2,1" {
+		t.Errorf("bad expired snapshots, got %v", ids)
+	}
+}
+
+func TestSnapshotRetentionByIntervalType(t *testing.T) {
+	snapshots := []Snapshot{
+		{ID: "manual", Created: "2018-05-01T12:00:00+0200", SnapshotType: "MANUAL", State: BackedUp},
+		{ID: "daily1", Created: "2018-05-01T12:00:00+0200", IntervalType: "DAILY", SnapshotType: "RECURRING", State: BackedUp},
+		{ID: "daily2", Created: "2018-05-02T12:00:00+0200", IntervalType: "DAILY", SnapshotType: "RECURRING", State: BackedUp},
+		{ID: "weekly1", Created: "2018-04-20T12:00:00+0200", IntervalType: "WEEKLY", SnapshotType: "RECURRING", State: BackedUp},
+		{ID: "weekly2", Created: "2018-04-27T12:00:00+0200", IntervalType: "WEEKLY", SnapshotType: "RECURRING", State: BackedUp},
+		{ID: "weekly3", Created: "2018-04-13T12:00:00+0200", IntervalType: "WEEKLY", SnapshotType: "RECURRING", State: BackedUp},
+		{ID: "hourly", Created: "2018-04-01T12:00:00+0200", IntervalType: "HOURLY", SnapshotType: "RECURRING", State: BackedUp},
+	}
+
+	policies := []SnapshotPolicy{
+		{IntervalType: DailyInterval, MaxSnaps: 1},
+		{IntervalType: WeeklyInterval, MaxSnaps: 2},
+	}
+	expired, err := ExpiredSnapshots(policies, snapshots)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ids := make([]string, len(expired))
+	for i, snapshot := range expired {
+		ids[i] = snapshot.ID
+	}
+
+	// the manual snapshot and the hourly one, without policy, survive
+	if strings.Join(ids, ",") != "daily1,weekly3" {
+		t.Errorf("bad expired snapshots, got %v", ids)
+	}
+}
+
+func TestSnapshotRetentionInvalidDate(t *testing.T) {
+	policies := []SnapshotPolicy{{IntervalType: DailyInterval, MaxSnaps: 1}}
+	if _, err := ExpiredSnapshots(policies, []Snapshot{{ID: "1", IntervalType: "DAILY", SnapshotType: "RECURRING", State: BackedUp, Created: "yesterday"}}); err == nil {
+		t.Error("an error was expected")
+	}
+}
+
+func TestSnapshotRetentionKeepingNothing(t *testing.T) {
+	snapshots := []Snapshot{{ID: "1", State: BackedUp, Created: "2018-05-01T12:00:00+0200"}}
+
+	tests := [][]SnapshotPolicy{
+		nil,
+		{{IntervalType: DailyInterval}},
+		{{IntervalType: DailyInterval, MaxSnaps: -1}},
+		{{IntervalType: IntervalType(42), MaxSnaps: 1}},
+	}
+
+	for _, policies := range tests {
+		if _, err := ExpiredSnapshots(policies, snapshots); err == nil {
+			t.Errorf("an error was expected for %#v", policies)
+		}
+	}
+}
+
+func TestApplySnapshotRetentionWithoutPolicy(t *testing.T) {
+	var queries []url.Values
+	ts := newRecordingServer(&queries, response{200, jsonContentType, `
+{"listsnapshotpoliciesresponse": {}}`}, response{200, jsonContentType, `
+{"listsnapshotsresponse": {
+	"count": 1,
+	"snapshot": [
+		{
+			"created": "2018-05-03T00:10:00+0200",
+			"id": "3e2b8c2a-0c5e-4f5b-9a59-2d9a3e0a8c01",
+			"state": "BackedUp",
+			"volumeid": "3613a751-5822-4d1d-b312-3036ef1acf86"
+		}
+	]
+}}`})
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+
+	if _, err := cs.ApplySnapshotRetention(context.Background(), "3613a751-5822-4d1d-b312-3036ef1acf86"); err == nil {
+		t.Fatal("an error was expected, the volume has no policy")
+	}
+
+	for _, query := range queries {
+		if query.Get("command") == "deleteSnapshot" {
+			t.Errorf("no snapshot should have been deleted")
+		}
+	}
+}
+
+func TestApplySnapshotRetention(t *testing.T) {
+	ts := newServer(response{200, jsonContentType, `
+{"listsnapshotsresponse": {
+	"count": 3,
+	"snapshot": [
+		{
+			"created": "2018-05-03T00:10:00+0200",
+			"id": "3e2b8c2a-0c5e-4f5b-9a59-2d9a3e0a8c01",
+			"intervaltype": "DAILY",
+			"snapshottype": "RECURRING",
+			"state": "BackedUp",
+			"volumeid": "3613a751-5822-4d1d-b312-3036ef1acf86"
+		},
+		{
+			"created": "2018-05-02T00:10:00+0200",
+			"id": "3e2b8c2a-0c5e-4f5b-9a59-2d9a3e0a8c02",
+			"intervaltype": "DAILY",
+			"snapshottype": "RECURRING",
+			"state": "BackedUp",
+			"volumeid": "3613a751-5822-4d1d-b312-3036ef1acf86"
+		},
+		{
+			"created": "2018-05-01T00:10:00+0200",
+			"id": "3e2b8c2a-0c5e-4f5b-9a59-2d9a3e0a8c03",
+			"intervaltype": "DAILY",
+			"snapshottype": "RECURRING",
+			"state": "BackedUp",
+			"volumeid": "3613a751-5822-4d1d-b312-3036ef1acf86"
+		}
+	]
+}}`}, response{200, jsonContentType, `
+{"deletesnapshotresponse": {
+	"jobid": "1",
+	"jobresult": {
+		"success": true
+	},
+	"jobstatus": 1
+}}`}, response{200, jsonContentType, `
+{"deletesnapshotresponse": {
+	"jobid": "2",
+	"jobresult": {
+		"success": true
+	},
+	"jobstatus": 1
+}}`})
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+
+	deleted, err := cs.ApplySnapshotRetention(context.Background(), "3613a751-5822-4d1d-b312-3036ef1acf86", SnapshotPolicy{
+		IntervalType: DailyInterval,
+		MaxSnaps:     1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(deleted) != 2 {
+		t.Fatalf("two deleted snapshots were expected, got %d", len(deleted))
+	}
+
+	if deleted[0].ID != "3e2b8c2a-0c5e-4f5b-9a59-2d9a3e0a8c02" {
+		t.Errorf("bad deleted snapshot, got %q", deleted[0].ID)
+	}
+}
+
+func TestApplySnapshotRetentionVolumePolicies(t *testing.T) {
+	var queries []url.Values
+	ts := newRecordingServer(&queries, response{200, jsonContentType, `
+{"listsnapshotpoliciesresponse": {
+	"count": 1,
+	"snapshotpolicy": [
+		{
+			"id": "1",
+			"intervaltype": 1,
+			"maxsnaps": 1,
+			"schedule": "10:00",
+			"timezone": "Europe/Zurich",
+			"volumeid": "3613a751-5822-4d1d-b312-3036ef1acf86"
+		}
+	]
+}}`}, response{200, jsonContentType, `
+{"listsnapshotsresponse": {
+	"count": 2,
+	"snapshot": [
+		{
+			"created": "2018-05-03T00:10:00+0200",
+			"id": "3e2b8c2a-0c5e-4f5b-9a59-2d9a3e0a8c01",
+			"intervaltype": "DAILY",
+			"snapshottype": "RECURRING",
+			"state": "BackedUp",
+			"volumeid": "3613a751-5822-4d1d-b312-3036ef1acf86"
+		},
+		{
+			"created": "2018-05-02T00:10:00+0200",
+			"id": "3e2b8c2a-0c5e-4f5b-9a59-2d9a3e0a8c02",
+			"intervaltype": "DAILY",
+			"snapshottype": "RECURRING",
+			"state": "BackedUp",
+			"volumeid": "3613a751-5822-4d1d-b312-3036ef1acf86"
+		}
+	]
+}}`}, response{200, jsonContentType, `
+{"deletesnapshotresponse": {
+	"jobid": "1",
+	"jobresult": {
+		"success": true
+	},
+	"jobstatus": 1
+}}`})
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+
+	deleted, err := cs.ApplySnapshotRetention(context.Background(), "3613a751-5822-4d1d-b312-3036ef1acf86")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(deleted) != 1 || deleted[0].ID != "3e2b8c2a-0c5e-4f5b-9a59-2d9a3e0a8c02" {
+		t.Errorf("one deleted snapshot was expected, got %#v", deleted)
+	}
+
+	if queries[0].Get("volumeid") != "3613a751-5822-4d1d-b312-3036ef1acf86" {
+		t.Errorf("the policies of the volume were expected, got %v", queries[0])
+	}
+}
@@ -68,14 +68,14 @@ type ListSnapshots struct {
 	DomainID     string        `json:"domainid,omitempty" doc:"list only resources belonging to the domain specified"`
 	ID           string        `json:"id,omitempty" doc:"lists snapshot by snapshot ID"`
 	IDs          []string      `json:"ids,omitempty" doc:"the IDs of the snapshots, mutually exclusive with id"`
-	IntervalType string        `json:"intervaltype,omitempty" doc:"valid values are HOURLY, DAILY, WEEKLY, and MONTHLY."`
+	IntervalType string        `json:"intervaltype,omitempty" enum:"hourly,daily,weekly,monthly" doc:"valid values are HOURLY, DAILY, WEEKLY, and MONTHLY."`
 	IsRecursive  *bool         `json:"isrecursive,omitempty" doc:"defaults to false, but if true, lists all resources from the parent specified by the domainId till leaves."`
 	Keyword      string        `json:"keyword,omitempty" doc:"List by keyword"`
 	ListAll      *bool         `json:"listall,omitempty" doc:"If set to false, list only resources belonging to the command's caller; if set to true - list resources that the caller is authorized to see. Default value is false"`
 	Name         string        `json:"name,omitempty" doc:"lists snapshot by snapshot name"`
 	Page         int           `json:"page,omitempty"`
 	PageSize     int           `json:"pagesize,omitempty"`
-	SnapshotType string        `json:"snapshottype,omitempty" doc:"valid values are MANUAL or RECURRING."`
+	SnapshotType string        `json:"snapshottype,omitempty" enum:"manual,recurring" doc:"valid values are MANUAL or RECURRING."`
 	Tags         []ResourceTag `json:"tags,omitempty" doc:"List resources by tags (key/value pairs)"`
 	VolumeID     string        `json:"volumeid,omitempty" doc:"the ID of the disk volume"`
 	ZoneID       string        `json:"zoneid,omitempty" doc:"list snapshots by zone id"`
@@ -100,3 +100,77 @@ type DeleteSnapshot struct {
 type RevertSnapshot struct {
 	ID string `json:"id" doc:"The ID of the snapshot"`
 }
+
+// IntervalType represents the SnapshotPolicy.IntervalType enum
+//
+// See: https://github.com/apache/cloudstack/blob/master/api/src/main/java/com/cloud/storage/snapshot/SnapshotSchedule.java
+type IntervalType int16
+
+const (
+	// HourlyInterval keeps one snapshot per hour
+	HourlyInterval IntervalType = iota
+	// DailyInterval keeps one snapshot per day
+	DailyInterval
+	// WeeklyInterval keeps one snapshot per week
+	WeeklyInterval
+	// MonthlyInterval keeps one snapshot per month
+	MonthlyInterval
+)
+
+// SnapshotPolicy represents a snapshot policy
+type SnapshotPolicy struct {
+	ForDisplay   bool         `json:"fordisplay,omitempty" doc:"is this policy for display to the regular user"`
+	ID           string       `json:"id,omitempty" doc:"the ID of the snapshot policy"`
+	IntervalType IntervalType `json:"intervaltype" doc:"the interval type of the snapshot policy"`
+	MaxSnaps     int          `json:"maxsnaps,omitempty" doc:"maximum number of snapshots retained"`
+	Schedule     string       `json:"schedule,omitempty" doc:"time the snapshot is scheduled to be taken."`
+	TimeZone     string       `json:"timezone,omitempty" doc:"the time zone of the snapshot policy"`
+	VolumeID     string       `json:"volumeid,omitempty" doc:"the ID of the disk volume"`
+}
+
+// CreateSnapshotPolicy creates a recurring snapshot schedule of a volume
+//
+// CloudStackAPI: http://cloudstack.apache.org/api/apidocs-4.10/apis/createSnapshotPolicy.html
+type CreateSnapshotPolicy struct {
+	IntervalType string `json:"intervaltype" enum:"hourly,daily,weekly,monthly" doc:"valid values are HOURLY, DAILY, WEEKLY, and MONTHLY"`
+	MaxSnaps     int    `json:"maxsnaps" doc:"maximum number of snapshots to retain"`
+	Schedule     string `json:"schedule" doc:"time the snapshot is scheduled to be taken. Format is:* if HOURLY, MM* if DAILY, MM:HH* if WEEKLY, MM:HH:DD (1-7)* if MONTHLY, MM:HH:DD (1-28)"`
+	TimeZone     string `json:"timezone" doc:"Specifies a timezone for this command. For more information on the timezone parameter, see Time Zone Format."`
+	VolumeID     string `json:"volumeid" doc:"the ID of the disk volume"`
+	ForDisplay   *bool  `json:"fordisplay,omitempty" doc:"an optional field, whether to the display the policy to the end user or not"`
+}
+
+// UpdateSnapshotPolicy (Async) updates a snapshot policy
+//
+// CloudStackAPI: http://cloudstack.apache.org/api/apidocs-4.10/apis/updateSnapshotPolicy.html
+type UpdateSnapshotPolicy struct {
+	ID         string `json:"id,omitempty" doc:"the ID of the snapshot policy"`
+	CustomID   string `json:"customid,omitempty" doc:"an optional field, in case you want to set a custom id to the resource. Allowed to Root Admins only"`
+	ForDisplay *bool  `json:"fordisplay,omitempty" doc:"an optional field, whether to the display the snapshot policy to the end user or not."`
+}
+
+// ListSnapshotPolicies lists the snapshot policies of a volume
+//
+// CloudStackAPI: http://cloudstack.apache.org/api/apidocs-4.10/apis/listSnapshotPolicies.html
+type ListSnapshotPolicies struct {
+	ForDisplay *bool  `json:"fordisplay,omitempty" doc:"list resources by display flag; only ROOT admin is eligible to pass this parameter"`
+	ID         string `json:"id,omitempty" doc:"the ID of the snapshot policy"`
+	Keyword    string `json:"keyword,omitempty" doc:"List by keyword"`
+	Page       int    `json:"page,omitempty"`
+	PageSize   int    `json:"pagesize,omitempty"`
+	VolumeID   string `json:"volumeid,omitempty" doc:"the ID of the disk volume"`
+}
+
+// ListSnapshotPoliciesResponse represents a list of snapshot policies
+type ListSnapshotPoliciesResponse struct {
+	Count          int              `json:"count"`
+	SnapshotPolicy []SnapshotPolicy `json:"snapshotpolicy"`
+}
+
+// DeleteSnapshotPolicies deletes snapshot policies
+//
+// CloudStackAPI: http://cloudstack.apache.org/api/apidocs-4.10/apis/deleteSnapshotPolicies.html
+type DeleteSnapshotPolicies struct {
+	ID  string   `json:"id,omitempty" doc:"the Id of the snapshot policy"`
+	IDs []string `json:"ids,omitempty" doc:"list of snapshots policy IDs separated by comma"`
+}
@@ -0,0 +1,160 @@
+package egoscale
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// GenerateSSHKey creates a new SSH key locally
+//
+// The bits are the size of the RSA key or of the ECDSA curve (256, 384 or 521),
+// 0 picks the default one. They are ignored for Ed25519.
+func GenerateSSHKey(keyType SSHKeyType, bits int) (*SSHKey, error) {
+	switch keyType {
+	case RSAKey:
+		if bits == 0 {
+			bits = 4096
+		}
+		key, err := rsa.GenerateKey(rand.Reader, bits)
+		if err != nil {
+			return nil, err
+		}
+		return newSSHKey(key, "ssh-rsa", sshString([]byte("ssh-rsa")), sshMPInt(big.NewInt(int64(key.E))), sshMPInt(key.N)), nil
+
+	case ECDSAKey:
+		var curve elliptic.Curve
+		switch bits {
+		case 0, 256:
+			curve = elliptic.P256()
+		case 384:
+			curve = elliptic.P384()
+		case 521:
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("invalid ECDSA key size %d, 256, 384 or 521 expected", bits)
+		}
+		key, err := ecdsa.GenerateKey(curve, rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		name := fmt.Sprintf("nistp%d", curve.Params().BitSize)
+		algorithm := "ecdsa-sha2-" + name
+		point := elliptic.Marshal(curve, key.X, key.Y) // nolint: staticcheck
+		return newSSHKey(key, algorithm, sshString([]byte(algorithm)), sshString([]byte(name)), sshString(point)), nil
+
+	case Ed25519Key:
+		return generateEd25519Key()
+	}
+
+	return nil, fmt.Errorf("unsupported SSH key type %q", keyType)
+}
+
+// newSSHKey builds the SSH key, the public key being given in the SSH wire format
+func newSSHKey(privateKey crypto.Signer, algorithm string, publicKey ...[]byte) *SSHKey {
+	blob := make([]byte, 0)
+	for _, b := range publicKey {
+		blob = append(blob, b...)
+	}
+
+	return &SSHKey{
+		PrivateKey: privateKey,
+		PublicKey:  fmt.Sprintf("%s %s", algorithm, base64.StdEncoding.EncodeToString(blob)),
+	}
+}
+
+// sshString encodes the bytes as an SSH string, prefixed by its length
+func sshString(b []byte) []byte {
+	s := make([]byte, 4, 4+len(b))
+	binary.BigEndian.PutUint32(s, uint32(len(b)))
+	return append(s, b...)
+}
+
+// sshMPInt encodes the positive integer as an SSH mpint
+func sshMPInt(n *big.Int) []byte {
+	b := n.Bytes()
+	if len(b) > 0 && b[0]&0x80 != 0 {
+		b = append([]byte{0}, b...)
+	}
+	return sshString(b)
+}
+
+// PrivateKeyPEM encodes the private key: PKCS#1 for RSA, SEC 1 for ECDSA and the OpenSSH format for Ed25519
+func (key *SSHKey) PrivateKeyPEM() ([]byte, error) {
+	var block *pem.Block
+
+	switch k := key.PrivateKey.(type) {
+	case *rsa.PrivateKey:
+		block = &pem.Block{
+			Type:  "RSA PRIVATE KEY",
+			Bytes: x509.MarshalPKCS1PrivateKey(k),
+		}
+	case *ecdsa.PrivateKey:
+		der, err := x509.MarshalECPrivateKey(k)
+		if err != nil {
+			return nil, err
+		}
+		block = &pem.Block{
+			Type:  "EC PRIVATE KEY",
+			Bytes: der,
+		}
+	default:
+		var err error
+		block, err = marshalEd25519PrivateKey(k)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return pem.EncodeToMemory(block), nil
+}
+
+// Fingerprint returns the MD5 fingerprint of the public key, see SSHKeyFingerprint
+func (key *SSHKey) Fingerprint() (string, error) {
+	return SSHKeyFingerprint(key.PublicKey)
+}
+
+// SSHKeyFingerprint computes the MD5 fingerprint of a public key in the authorized_keys format
+//
+// This is the format of SSHKeyPair.Fingerprint, e.g. 1e:2a:...:9f
+func SSHKeyFingerprint(publicKey string) (string, error) {
+	fields := strings.Fields(publicKey)
+	if len(fields) < 2 {
+		return "", fmt.Errorf("invalid public key, \"<algorithm> <base64 key> [comment]\" expected")
+	}
+
+	blob, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		return "", fmt.Errorf("invalid public key, %s", err)
+	}
+
+	// the blob starts with the algorithm, as an SSH string
+	if len(blob) < 4 {
+		return "", fmt.Errorf("invalid public key, the algorithm is missing")
+	}
+	size := binary.BigEndian.Uint32(blob)
+	if uint64(len(blob)-4) < uint64(size) {
+		return "", fmt.Errorf("invalid public key, the algorithm is truncated")
+	}
+	if algorithm := string(blob[4 : 4+size]); algorithm != fields[0] {
+		return "", fmt.Errorf("invalid public key, the algorithm %q doesn't match the key %q", fields[0], algorithm)
+	}
+
+	sum := md5.Sum(blob)
+	hex := make([]string, len(sum))
+	for i, b := range sum {
+		hex[i] = fmt.Sprintf("%02x", b)
+	}
+
+	return strings.Join(hex, ":"), nil
+}
@@ -0,0 +1,67 @@
+//go:build go1.13
+// +build go1.13
+
+package egoscale
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+)
+
+// generateEd25519Key creates a new Ed25519 SSH key
+func generateEd25519Key() (*SSHKey, error) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return newSSHKey(privateKey, "ssh-ed25519", ed25519PublicKey(publicKey)), nil
+}
+
+// ed25519PublicKey encodes the public key in the SSH wire format
+func ed25519PublicKey(publicKey ed25519.PublicKey) []byte {
+	return append(sshString([]byte("ssh-ed25519")), sshString(publicKey)...)
+}
+
+// marshalEd25519PrivateKey encodes the private key in the unencrypted OpenSSH format,
+// the only one OpenSSH reads for Ed25519
+func marshalEd25519PrivateKey(key crypto.Signer) (*pem.Block, error) {
+	privateKey, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key %T", key)
+	}
+
+	publicKey := ed25519PublicKey(privateKey.Public().(ed25519.PublicKey))
+
+	check := make([]byte, 4)
+	if _, err := rand.Read(check); err != nil {
+		return nil, err
+	}
+
+	private := append(check, check...)
+	private = append(private, publicKey...)
+	private = append(private, sshString(privateKey)...)
+	private = append(private, sshString(nil)...) // comment
+	for i := byte(1); len(private)%8 != 0; i++ {
+		private = append(private, i)
+	}
+
+	keys := make([]byte, 4)
+	binary.BigEndian.PutUint32(keys, 1)
+
+	data := append([]byte("openssh-key-v1\x00"), sshString([]byte("none"))...) // cipher
+	data = append(data, sshString([]byte("none"))...)                          // kdf
+	data = append(data, sshString(nil)...)                                     // kdf options
+	data = append(data, keys...)
+	data = append(data, sshString(publicKey)...)
+	data = append(data, sshString(private)...)
+
+	return &pem.Block{
+		Type:  "OPENSSH PRIVATE KEY",
+		Bytes: data,
+	}, nil
+}
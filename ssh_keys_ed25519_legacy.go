@@ -0,0 +1,20 @@
+//go:build !go1.13
+// +build !go1.13
+
+package egoscale
+
+import (
+	"crypto"
+	"encoding/pem"
+	"fmt"
+)
+
+// generateEd25519Key fails, crypto/ed25519 was added in Go 1.13
+func generateEd25519Key() (*SSHKey, error) {
+	return nil, fmt.Errorf("Ed25519 keys require Go 1.13 or later")
+}
+
+// marshalEd25519PrivateKey fails, no Ed25519 key may be generated
+func marshalEd25519PrivateKey(key crypto.Signer) (*pem.Block, error) {
+	return nil, fmt.Errorf("unsupported private key %T", key)
+}
@@ -0,0 +1,35 @@
+//go:build go1.13
+// +build go1.13
+
+package egoscale
+
+import (
+	"encoding/pem"
+	"strings"
+	"testing"
+)
+
+func TestGenerateSSHKeyEd25519(t *testing.T) {
+	key, err := GenerateSSHKey(Ed25519Key, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasPrefix(key.PublicKey, "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5") {
+		t.Errorf("public key doesn't match, got %q", key.PublicKey)
+	}
+
+	privateKey, err := key.PrivateKeyPEM()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	block, _ := pem.Decode(privateKey)
+	if block == nil || block.Type != "OPENSSH PRIVATE KEY" {
+		t.Fatalf("PEM block doesn't match, got %v", block)
+	}
+
+	if !strings.HasPrefix(string(block.Bytes), "openssh-key-v1\x00") {
+		t.Error("OpenSSH magic is missing")
+	}
+}
@@ -0,0 +1,102 @@
+package egoscale
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"strings"
+	"testing"
+)
+
+func TestSSHKeyFingerprint(t *testing.T) {
+	fingerprint, err := SSHKeyFingerprint("ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIBjwGTdtJomMSPo0NhRYtlQbODdQ/rvVM/duUKCGABfM test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if fingerprint != "6c:06:cd:3b:17:a3:39:a9:de:b2:f3:63:46:87:b9:b8" {
+		t.Errorf("fingerprint doesn't match, got %q", fingerprint)
+	}
+
+	for _, publicKey := range []string{
+		"",
+		"ssh-rsa",
+		"ssh-rsa not-base64!",
+		"ssh-rsa AAAAC3NzaC1lZDI1NTE5AAAAIBjwGTdtJomMSPo0NhRYtlQbODdQ/rvVM/duUKCGABfM test",
+		"ssh-ed25519 AAAA",
+		"ssh-ed25519 AAAAIA==",
+	} {
+		if _, err := SSHKeyFingerprint(publicKey); err == nil {
+			t.Errorf("an error was expected for %q", publicKey)
+		}
+	}
+}
+
+func TestGenerateSSHKey(t *testing.T) {
+	tests := []struct {
+		keyType   SSHKeyType
+		bits      int
+		algorithm string
+		pemType   string
+	}{
+		{RSAKey, 1024, "ssh-rsa", "RSA PRIVATE KEY"},
+		{ECDSAKey, 0, "ecdsa-sha2-nistp256", "EC PRIVATE KEY"},
+		{ECDSAKey, 384, "ecdsa-sha2-nistp384", "EC PRIVATE KEY"},
+		{ECDSAKey, 521, "ecdsa-sha2-nistp521", "EC PRIVATE KEY"},
+	}
+
+	for _, test := range tests {
+		key, err := GenerateSSHKey(test.keyType, test.bits)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !strings.HasPrefix(key.PublicKey, test.algorithm+" ") {
+			t.Errorf("%s public key doesn't match, got %q", test.algorithm, key.PublicKey)
+		}
+
+		if _, err := key.Fingerprint(); err != nil {
+			t.Error(err)
+		}
+
+		privateKey, err := key.PrivateKeyPEM()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		block, _ := pem.Decode(privateKey)
+		if block == nil || block.Type != test.pemType {
+			t.Fatalf("%s PEM block doesn't match, got %v", test.algorithm, block)
+		}
+	}
+}
+
+func TestGenerateSSHKeyFailure(t *testing.T) {
+	if _, err := GenerateSSHKey(ECDSAKey, 128); err == nil {
+		t.Error("an error was expected for an invalid curve")
+	}
+
+	if _, err := GenerateSSHKey(SSHKeyType("dsa"), 0); err == nil {
+		t.Error("an error was expected for an unsupported key type")
+	}
+}
+
+func TestGenerateSSHKeyDecryptPassword(t *testing.T) {
+	key, err := GenerateSSHKey(RSAKey, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	privateKey, err := key.PrivateKeyPEM()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	block, _ := pem.Decode(privateKey)
+	if _, err := x509.ParsePKCS1PrivateKey(block.Bytes); err != nil {
+		t.Error(err)
+	}
+
+	if _, err := (&Password{EncryptedPassword: "bm9wZQ=="}).Decrypt(privateKey); err == nil {
+		t.Error("an error was expected for a password not encrypted with this key")
+	}
+}
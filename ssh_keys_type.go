@@ -0,0 +1,23 @@
+package egoscale
+
+import "crypto"
+
+// SSHKeyType represents the algorithm of an SSH key
+type SSHKeyType string
+
+const (
+	// RSAKey represents an RSA key, 4096 bits by default
+	RSAKey SSHKeyType = "rsa"
+	// ECDSAKey represents an ECDSA key, on the P-256 curve by default
+	ECDSAKey SSHKeyType = "ecdsa"
+	// Ed25519Key represents an Ed25519 key, it requires Go 1.13
+	Ed25519Key SSHKeyType = "ed25519"
+)
+
+// SSHKey represents a locally generated SSH key, see GenerateSSHKey
+type SSHKey struct {
+	// PrivateKey is the *rsa.PrivateKey, *ecdsa.PrivateKey or ed25519.PrivateKey
+	PrivateKey crypto.Signer
+	// PublicKey is in the authorized_keys format, as expected by RegisterSSHKeyPair
+	PublicKey string
+}
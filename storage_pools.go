@@ -0,0 +1,39 @@
+package egoscale
+
+import "fmt"
+
+func (*ListStoragePools) name() string {
+	return "listStoragePools"
+}
+
+func (*ListStoragePools) description() string {
+	return "Lists storage pools."
+}
+
+func (*ListStoragePools) response() interface{} {
+	return new(ListStoragePoolsResponse)
+}
+
+// SetPage sets the current page
+func (ls *ListStoragePools) SetPage(page int) {
+	ls.Page = page
+}
+
+// SetPageSize sets the page size
+func (ls *ListStoragePools) SetPageSize(pageSize int) {
+	ls.PageSize = pageSize
+}
+
+func (*ListStoragePools) each(resp interface{}, callback IterateItemFunc) {
+	pools, ok := resp.(*ListStoragePoolsResponse)
+	if !ok {
+		callback(nil, fmt.Errorf("wrong type. ListStoragePoolsResponse expected, got %T", resp))
+		return
+	}
+
+	for i := range pools.StoragePool {
+		if !callback(&pools.StoragePool[i], nil) {
+			break
+		}
+	}
+}
@@ -0,0 +1,13 @@
+package egoscale
+
+import (
+	"testing"
+)
+
+func TestListStoragePools(t *testing.T) {
+	req := &ListStoragePools{}
+	if req.name() != "listStoragePools" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.response().(*ListStoragePoolsResponse)
+}
@@ -0,0 +1,49 @@
+package egoscale
+
+// StoragePool represents a primary storage
+type StoragePool struct {
+	ClusterID            string `json:"clusterid,omitempty" doc:"the ID of the cluster for the storage pool"`
+	ClusterName          string `json:"clustername,omitempty" doc:"the name of the cluster for the storage pool"`
+	Created              string `json:"created,omitempty" doc:"the date and time the storage pool was created"`
+	DiskSizeAllocated    int64  `json:"disksizeallocated,omitempty" doc:"the host's currently allocated disk size"`
+	DiskSizeTotal        int64  `json:"disksizetotal,omitempty" doc:"the total disk size of the storage pool"`
+	DiskSizeUsed         int64  `json:"disksizeused,omitempty" doc:"the host's currently used disk size"`
+	Hypervisor           string `json:"hypervisor,omitempty" doc:"the hypervisor type of the storage pool"`
+	ID                   string `json:"id,omitempty" doc:"the ID of the storage pool"`
+	IPAddress            string `json:"ipaddress,omitempty" doc:"the IP address of the storage pool"`
+	Name                 string `json:"name,omitempty" doc:"the name of the storage pool"`
+	OverProvisionFactor  string `json:"overprovisionfactor,omitempty" doc:"the overprovisionfactor for the storage pool"`
+	Path                 string `json:"path,omitempty" doc:"the storage pool path"`
+	PodID                string `json:"podid,omitempty" doc:"the Pod ID of the storage pool"`
+	PodName              string `json:"podname,omitempty" doc:"the Pod name of the storage pool"`
+	Scope                string `json:"scope,omitempty" doc:"the scope of the storage pool"`
+	State                string `json:"state,omitempty" doc:"the state of the storage pool"`
+	SuitableForMigration bool   `json:"suitableformigration,omitempty" doc:"true if this pool is suitable to migrate a volume, false otherwise"`
+	Tags                 string `json:"tags,omitempty" doc:"the tags for the storage pool"`
+	Type                 string `json:"type,omitempty" doc:"the storage pool type"`
+	ZoneID               string `json:"zoneid,omitempty" doc:"the Zone ID of the storage pool"`
+	ZoneName             string `json:"zonename,omitempty" doc:"the Zone name of the storage pool"`
+}
+
+// ListStoragePools represents a query to list the primary storages
+//
+// CloudStack API: https://cloudstack.apache.org/api/apidocs-4.10/apis/listStoragePools.html
+type ListStoragePools struct {
+	ClusterID string `json:"clusterid,omitempty" doc:"list storage pools belongig to the specific cluster"`
+	ID        string `json:"id,omitempty" doc:"the ID of the storage pool"`
+	IPAddress string `json:"ipaddress,omitempty" doc:"the IP address for the storage pool"`
+	Keyword   string `json:"keyword,omitempty" doc:"List by keyword"`
+	Name      string `json:"name,omitempty" doc:"the name of the storage pool"`
+	Page      int    `json:"page,omitempty"`
+	PageSize  int    `json:"pagesize,omitempty"`
+	Path      string `json:"path,omitempty" doc:"the storage pool path"`
+	PodID     string `json:"podid,omitempty" doc:"the Pod ID for the storage pool"`
+	Scope     string `json:"scope,omitempty" doc:"the ID of the storage pool"`
+	ZoneID    string `json:"zoneid,omitempty" doc:"the Zone ID for the storage pool"`
+}
+
+// ListStoragePoolsResponse represents a list of primary storages
+type ListStoragePoolsResponse struct {
+	Count       int           `json:"count"`
+	StoragePool []StoragePool `json:"storagepool"`
+}
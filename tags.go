@@ -1,5 +1,205 @@
 package egoscale
 
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// Tag attaches the tags to the given resource, see TagResources
+func (client *Client) Tag(ctx context.Context, resource Taggable, tags map[string]string) error {
+	return client.TagResources(ctx, []Taggable{resource}, tags)
+}
+
+// TagResources attaches the tags to all the resources, using one CreateTags call per type of resource
+func (client *Client) TagResources(ctx context.Context, resources []Taggable, tags map[string]string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	batches, err := batchByResourceType(resources)
+	if err != nil {
+		return err
+	}
+
+	resourceTags := makeResourceTags(tags)
+	for _, batch := range batches {
+		err := client.BooleanRequestWithContext(ctx, &CreateTags{
+			ResourceIDs:  batch.ids,
+			ResourceType: batch.resourceType,
+			Tags:         resourceTags,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Untag removes the given keys from the resource
+//
+// Without any keys, all the tags of the resource are removed.
+func (client *Client) Untag(ctx context.Context, resource Taggable, keys ...string) error {
+	return client.UntagResources(ctx, []Taggable{resource}, keys...)
+}
+
+// UntagResources removes the given keys from all the resources, using one DeleteTags call per type of resource
+//
+// Without any keys, all the tags of the resources are removed.
+func (client *Client) UntagResources(ctx context.Context, resources []Taggable, keys ...string) error {
+	var resourceTags []ResourceTag
+	if len(keys) > 0 {
+		resourceTags = make([]ResourceTag, len(keys))
+		for i, key := range keys {
+			resourceTags[i] = ResourceTag{Key: key}
+		}
+	}
+
+	batches, err := batchByResourceType(resources)
+	if err != nil {
+		return err
+	}
+
+	for _, batch := range batches {
+		err := client.BooleanRequestWithContext(ctx, &DeleteTags{
+			ResourceIDs:  batch.ids,
+			ResourceType: batch.resourceType,
+			Tags:         resourceTags,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Tags fetches the tags of the given resource
+func (client *Client) Tags(ctx context.Context, resource Taggable) (map[string]string, error) {
+	// without the id, the tags of all the resources of that type would be listed
+	if resource.ResourceID() == "" {
+		return nil, fmt.Errorf("the tags of a %s may only be fetched using ID", resource.ResourceType())
+	}
+
+	req := &ListTags{
+		ResourceID:   resource.ResourceID(),
+		ResourceType: resource.ResourceType(),
+	}
+
+	tags := make(map[string]string)
+
+	var err error
+	client.PaginateWithContext(ctx, req, func(item interface{}, e error) bool {
+		if e != nil {
+			err = e
+			return false
+		}
+		tag := item.(*ResourceTag)
+		tags[tag.Key] = tag.Value
+		return true
+	})
+
+	return tags, err
+}
+
+// ReplaceTags sets the tags of the resource, the missing or modified ones are removed first
+func (client *Client) ReplaceTags(ctx context.Context, resource Taggable, tags map[string]string) error {
+	current, err := client.Tags(ctx, resource)
+	if err != nil {
+		return err
+	}
+
+	removed := make([]string, 0)
+	added := make(map[string]string)
+	for key, value := range current {
+		if v, ok := tags[key]; !ok || v != value {
+			removed = append(removed, key)
+		}
+	}
+	for key, value := range tags {
+		if v, ok := current[key]; !ok || v != value {
+			added[key] = value
+		}
+	}
+
+	if len(removed) > 0 {
+		sort.Strings(removed)
+		if err := client.Untag(ctx, resource, removed...); err != nil {
+			return err
+		}
+	}
+
+	return client.Tag(ctx, resource, added)
+}
+
+// makeResourceTags builds the tags sorted by key
+func makeResourceTags(tags map[string]string) []ResourceTag {
+	resourceTags := make([]ResourceTag, 0, len(tags))
+	for key, value := range tags {
+		resourceTags = append(resourceTags, ResourceTag{
+			Key:   key,
+			Value: value,
+		})
+	}
+
+	sort.Slice(resourceTags, func(i, j int) bool {
+		return resourceTags[i].Key < resourceTags[j].Key
+	})
+
+	return resourceTags
+}
+
+// maxBatchSize bounds the number of resources per call, hence the size of the request
+const maxBatchSize = 100
+
+type resourceBatch struct {
+	resourceType string
+	ids          []string
+}
+
+// batchByResourceType groups the resources ids by type, keeping their order
+//
+// A batch holds at most maxBatchSize ids, every resource must have an id.
+func batchByResourceType(resources []Taggable) ([]resourceBatch, error) {
+	batches := make([]resourceBatch, 0)
+	index := make(map[string]int)
+
+	for _, resource := range resources {
+		resourceType := resource.ResourceType()
+		id := resource.ResourceID()
+		if id == "" {
+			return nil, fmt.Errorf("a %s may only be tagged using ID", resourceType)
+		}
+
+		i, ok := index[resourceType]
+		if !ok || len(batches[i].ids) >= maxBatchSize {
+			i = len(batches)
+			index[resourceType] = i
+			batches = append(batches, resourceBatch{resourceType: resourceType})
+		}
+		batches[i].ids = append(batches[i].ids, id)
+	}
+
+	return batches, nil
+}
+
+// tagFilter keeps only the key and value of the tags, to be used as a list filter
+func tagFilter(tags []ResourceTag) []ResourceTag {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	filter := make([]ResourceTag, len(tags))
+	for i, tag := range tags {
+		filter[i] = ResourceTag{
+			Key:   tag.Key,
+			Value: tag.Value,
+		}
+	}
+	return filter
+}
+
 func (*CreateTags) name() string {
 	return "createTags"
 }
@@ -35,3 +235,27 @@ func (*ListTags) description() string {
 func (*ListTags) response() interface{} {
 	return new(ListTagsResponse)
 }
+
+// SetPage sets the current page
+func (ls *ListTags) SetPage(page int) {
+	ls.Page = page
+}
+
+// SetPageSize sets the page size
+func (ls *ListTags) SetPageSize(pageSize int) {
+	ls.PageSize = pageSize
+}
+
+func (*ListTags) each(resp interface{}, callback IterateItemFunc) {
+	tags, ok := resp.(*ListTagsResponse)
+	if !ok {
+		callback(nil, fmt.Errorf("wrong type. ListTagsResponse expected, got %T", resp))
+		return
+	}
+
+	for i := range tags.Tag {
+		if !callback(&tags.Tag[i], nil) {
+			break
+		}
+	}
+}
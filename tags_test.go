@@ -1,6 +1,10 @@
 package egoscale
 
 import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
 	"testing"
 )
 
@@ -8,6 +12,7 @@ func TestTags(t *testing.T) {
 	var _ AsyncCommand = (*CreateTags)(nil)
 	var _ AsyncCommand = (*DeleteTags)(nil)
 	var _ syncCommand = (*ListTags)(nil)
+	var _ ListCommand = (*ListTags)(nil)
 }
 
 func TestCreateTags(t *testing.T) {
@@ -33,3 +38,215 @@ func TestListTags(t *testing.T) {
 	}
 	_ = req.response().(*ListTagsResponse)
 }
+
+func TestListRequestTags(t *testing.T) {
+	cs := NewClient("https://example.com/", "KEY", "SECRET")
+
+	tags := []ResourceTag{{
+		Key:          "env",
+		Value:        "prod",
+		ResourceID:   "1",
+		ResourceType: "UserVm",
+	}}
+
+	things := []Listable{
+		&IPAddress{Tags: tags},
+		&ISO{Tags: tags},
+		&LoadBalancerRule{Tags: tags},
+		&Network{Tags: tags},
+		&PortForwardingRule{Tags: tags},
+		&SecurityGroup{Tags: tags},
+		&Snapshot{Tags: tags},
+		&Template{Tags: tags},
+		&VirtualMachine{Tags: tags},
+		&VMSnapshot{Tags: tags},
+		&Volume{Tags: tags},
+		&Zone{Tags: tags},
+	}
+
+	for _, thing := range things {
+		req, err := thing.ListRequest()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		payload, err := cs.Payload(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !strings.Contains(payload, "tags[0].key=env") || !strings.Contains(payload, "tags[0].value=prod") {
+			t.Errorf("%T: the tag filter was expected, got %q", thing, payload)
+		}
+
+		if strings.Contains(payload, "resourceid") {
+			t.Errorf("%T: only the key and value were expected, got %q", thing, payload)
+		}
+	}
+}
+
+func TestClientTagResources(t *testing.T) {
+	queries := make([]url.Values, 0)
+	ts := newRecordingServer(&queries,
+		response{200, jsonContentType, `{"createtagsresponse": {"jobid": "1", "jobresult": {"success": true}, "jobstatus": 1}}`},
+		response{200, jsonContentType, `{"createtagsresponse": {"jobid": "2", "jobresult": {"success": true}, "jobstatus": 1}}`},
+	)
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+
+	resources := []Taggable{
+		&VirtualMachine{ID: "vm1"},
+		&Volume{ID: "vol1"},
+		&VirtualMachine{ID: "vm2"},
+	}
+
+	err := cs.TagResources(context.TODO(), resources, map[string]string{"team": "blue", "env": "prod"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(queries) != 2 {
+		t.Fatalf("two calls were expected, got %d", len(queries))
+	}
+
+	vms := queries[0]
+	if vms.Get("resourcetype") != "UserVM" || vms.Get("resourceids") != "vm1,vm2" {
+		t.Errorf("bad virtual machines batch, got %v", vms)
+	}
+
+	if vms.Get("tags[0].key") != "env" || vms.Get("tags[1].key") != "team" || vms.Get("tags[1].value") != "blue" {
+		t.Errorf("bad tags, got %v", vms)
+	}
+
+	if queries[1].Get("resourcetype") != "Volume" || queries[1].Get("resourceids") != "vol1" {
+		t.Errorf("bad volumes batch, got %v", queries[1])
+	}
+}
+
+func TestClientTagWithoutTags(t *testing.T) {
+	ts := newServer()
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+
+	if err := cs.Tag(context.TODO(), &VirtualMachine{ID: "vm1"}, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestClientUntag(t *testing.T) {
+	queries := make([]url.Values, 0)
+	ts := newRecordingServer(&queries,
+		response{200, jsonContentType, `{"deletetagsresponse": {"jobid": "1", "jobresult": {"success": true}, "jobstatus": 1}}`},
+	)
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+
+	if err := cs.Untag(context.TODO(), &SecurityGroup{ID: "sg1"}, "team"); err != nil {
+		t.Fatal(err)
+	}
+
+	query := queries[0]
+	if query.Get("command") != "deleteTags" || query.Get("resourcetype") != "SecurityGroup" || query.Get("tags[0].key") != "team" {
+		t.Errorf("bad deleteTags call, got %v", query)
+	}
+
+	if _, ok := query["tags[0].value"]; ok {
+		t.Errorf("no value was expected, got %v", query)
+	}
+}
+
+func TestClientReplaceTags(t *testing.T) {
+	queries := make([]url.Values, 0)
+	ts := newRecordingServer(&queries,
+		response{200, jsonContentType, `
+{"listtagsresponse": {
+	"count": 3,
+	"tag": [
+		{"key": "env", "value": "staging", "resourceid": "vm1", "resourcetype": "UserVm"},
+		{"key": "team", "value": "blue", "resourceid": "vm1", "resourcetype": "UserVm"},
+		{"key": "old", "value": "yes", "resourceid": "vm1", "resourcetype": "UserVm"}
+	]
+}}`},
+		response{200, jsonContentType, `{"deletetagsresponse": {"jobid": "1", "jobresult": {"success": true}, "jobstatus": 1}}`},
+		response{200, jsonContentType, `{"createtagsresponse": {"jobid": "2", "jobresult": {"success": true}, "jobstatus": 1}}`},
+	)
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+
+	err := cs.ReplaceTags(context.TODO(), &VirtualMachine{ID: "vm1"}, map[string]string{
+		"env":  "prod",
+		"team": "blue",
+		"new":  "yes",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(queries) != 3 {
+		t.Fatalf("three calls were expected, got %d", len(queries))
+	}
+
+	if queries[0].Get("resourceid") != "vm1" || queries[0].Get("resourcetype") != "UserVM" {
+		t.Errorf("bad listTags call, got %v", queries[0])
+	}
+
+	deleted := queries[1]
+	if deleted.Get("tags[0].key") != "env" || deleted.Get("tags[1].key") != "old" || deleted.Get("tags[2].key") != "" {
+		t.Errorf("bad deleteTags call, got %v", deleted)
+	}
+
+	created := queries[2]
+	if created.Get("tags[0].key") != "env" || created.Get("tags[0].value") != "prod" || created.Get("tags[1].key") != "new" || created.Get("tags[2].key") != "" {
+		t.Errorf("bad createTags call, got %v", created)
+	}
+}
+
+func TestClientTagsWithoutID(t *testing.T) {
+	ts := newServer()
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+	vm := &VirtualMachine{Name: "foo"}
+
+	if _, err := cs.Tags(context.TODO(), vm); err == nil {
+		t.Error("an error was expected, the resource has no ID")
+	}
+
+	if err := cs.ReplaceTags(context.TODO(), vm, map[string]string{"env": "prod"}); err == nil {
+		t.Error("an error was expected, the resource has no ID")
+	}
+
+	if err := cs.Untag(context.TODO(), vm); err == nil {
+		t.Error("an error was expected, the resource has no ID")
+	}
+
+	if err := cs.Tag(context.TODO(), vm, map[string]string{"env": "prod"}); err == nil {
+		t.Error("an error was expected, the resource has no ID")
+	}
+}
+
+func TestBatchByResourceTypeSize(t *testing.T) {
+	resources := make([]Taggable, 0, 2*maxBatchSize+1)
+	for i := 0; i < 2*maxBatchSize+1; i++ {
+		resources = append(resources, &VirtualMachine{ID: fmt.Sprintf("vm%d", i)})
+	}
+	resources = append(resources, &Volume{ID: "vol1"})
+
+	batches, err := batchByResourceType(resources)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sizes := make([]int, len(batches))
+	for i, batch := range batches {
+		sizes[i] = len(batch.ids)
+	}
+
+	if fmt.Sprint(sizes) != fmt.Sprint([]int{maxBatchSize, maxBatchSize, 1, 1}) {
+		t.Errorf("bad batch sizes, got %v", sizes)
+	}
+}
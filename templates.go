@@ -1,9 +1,22 @@
 package egoscale
 
 import (
+	"context"
 	"fmt"
 )
 
+// Delete removes the given template from all the zones or from its zone if set
+func (temp *Template) Delete(ctx context.Context, client *Client) error {
+	if temp.ID == "" {
+		return fmt.Errorf("a Template may only be deleted using ID")
+	}
+
+	return client.BooleanRequestWithContext(ctx, &DeleteTemplate{
+		ID:     temp.ID,
+		ZoneID: temp.ZoneID,
+	})
+}
+
 // ListRequest builds the ListTemplates request
 func (temp *Template) ListRequest() (ListCommand, error) {
 	req := &ListTemplates{
@@ -13,13 +26,16 @@ func (temp *Template) ListRequest() (ListCommand, error) {
 		ID:         temp.ID,
 		ZoneID:     temp.ZoneID,
 		Hypervisor: temp.Hypervisor,
-		//TODO Tags
+		Tags:       tagFilter(temp.Tags),
 	}
 	if temp.IsFeatured {
 		req.TemplateFilter = "featured"
+	} else {
+		req.TemplateFilter = "executable"
 	}
 	if temp.Removed != "" {
-		*req.ShowRemoved = true
+		showRemoved := true
+		req.ShowRemoved = &showRemoved
 	}
 
 	return req, nil
@@ -54,6 +70,11 @@ func (*Template) ResourceType() string {
 	return "Template"
 }
 
+// ResourceID returns the identifier of the resource
+func (temp *Template) ResourceID() string {
+	return temp.ID
+}
+
 func (*ListTemplates) name() string {
 	return "listTemplates"
 }
@@ -46,7 +46,7 @@ type Template struct {
 //
 // CloudStackAPI: http://cloudstack.apache.org/api/apidocs-4.10/apis/listTemplates.html
 type ListTemplates struct {
-	TemplateFilter string        `json:"templatefilter" doc:"possible values are \"featured\", \"self\", \"selfexecutable\",\"sharedexecutable\",\"executable\", and \"community\". * featured : templates that have been marked as featured and public. * self : templates that have been registered or created by the calling user. * selfexecutable : same as self, but only returns templates that can be used to deploy a new VM. * sharedexecutable : templates ready to be deployed that have been granted to the calling user by another user. * executable : templates that are owned by the calling user, or public templates, that can be used to deploy a VM. * community : templates that have been marked as public but not featured. * all : all templates (only usable by admins)."`
+	TemplateFilter string        `json:"templatefilter" enum:"featured,self,selfexecutable,sharedexecutable,executable,community,all" doc:"possible values are \"featured\", \"self\", \"selfexecutable\",\"sharedexecutable\",\"executable\", and \"community\". * featured : templates that have been marked as featured and public. * self : templates that have been registered or created by the calling user. * selfexecutable : same as self, but only returns templates that can be used to deploy a new VM. * sharedexecutable : templates ready to be deployed that have been granted to the calling user by another user. * executable : templates that are owned by the calling user, or public templates, that can be used to deploy a VM. * community : templates that have been marked as public but not featured. * all : all templates (only usable by admins)."`
 	Account        string        `json:"account,omitempty" doc:"list resources by account. Must be used with the domainId parameter."`
 	DomainID       string        `json:"domainid,omitempty" doc:"list only resources belonging to the domain specified"`
 	Hypervisor     string        `json:"hypervisor,omitempty" doc:"the hypervisor for which to restrict the search"`
@@ -0,0 +1,197 @@
+package egoscale
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// usageDateLayout is the date format expected by ListUsageRecords
+const usageDateLayout = "2006-01-02"
+
+// IsTimeBased tells whether the raw usage is a duration, in hours, rather than an amount of bytes
+func (usageType UsageType) IsTimeBased() bool {
+	return usageType != NetworkBytesSent && usageType != NetworkBytesReceived
+}
+
+// UsageByResource groups the usage records by resource
+func UsageByResource(record *UsageRecord) string {
+	if record.UsageID != "" {
+		return record.UsageID
+	}
+	return record.VirtualMachineID
+}
+
+// UsageByAccount groups the usage records by account
+func UsageByAccount(record *UsageRecord) string {
+	return record.Account
+}
+
+// UsageByTag groups the usage records by the value of the given tag, see ListUsageRecords.IncludeTags
+func UsageByTag(key string) func(*UsageRecord) string {
+	return func(record *UsageRecord) string {
+		for _, tag := range record.Tags {
+			if tag.Key == key {
+				return tag.Value
+			}
+		}
+		return ""
+	}
+}
+
+// AggregateUsage sums up the usage records by group and by usage type
+//
+// The aggregates are sorted by key and usage type.
+func AggregateUsage(records []UsageRecord, by func(*UsageRecord) string) []UsageAggregate {
+	type group struct {
+		key       string
+		usageType UsageType
+	}
+
+	groups := make(map[group]*UsageAggregate)
+	for i := range records {
+		record := &records[i]
+		g := group{by(record), record.UsageType}
+
+		aggregate, ok := groups[g]
+		if !ok {
+			aggregate = &UsageAggregate{
+				Key:       g.key,
+				UsageType: g.usageType,
+			}
+			groups[g] = aggregate
+		}
+
+		aggregate.Records++
+		if !record.UsageType.IsTimeBased() {
+			aggregate.Bytes += record.RawUsage
+			continue
+		}
+
+		aggregate.Hours += record.RawUsage
+		if record.Size > 0 {
+			aggregate.GBHours += record.RawUsage * float64(record.Size) / (1 << 30)
+		}
+	}
+
+	aggregates := make([]UsageAggregate, 0, len(groups))
+	for _, aggregate := range groups {
+		aggregates = append(aggregates, *aggregate)
+	}
+
+	sort.Slice(aggregates, func(i, j int) bool {
+		if aggregates[i].Key != aggregates[j].Key {
+			return aggregates[i].Key < aggregates[j].Key
+		}
+		return aggregates[i].UsageType < aggregates[j].UsageType
+	})
+
+	return aggregates
+}
+
+// WriteUsageCSV exports the aggregates as CSV, with a header
+func WriteUsageCSV(w io.Writer, aggregates []UsageAggregate) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write([]string{"key", "usagetype", "records", "hours", "gbhours", "bytes"}); err != nil {
+		return err
+	}
+
+	for _, aggregate := range aggregates {
+		err := writer.Write([]string{
+			aggregate.Key,
+			aggregate.UsageType.String(),
+			strconv.Itoa(aggregate.Records),
+			strconv.FormatFloat(aggregate.Hours, 'f', 2, 64),
+			strconv.FormatFloat(aggregate.GBHours, 'f', 2, 64),
+			strconv.FormatFloat(aggregate.Bytes, 'f', 0, 64),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// UsageReport aggregates the usage records of the given period, see AggregateUsage
+//
+// The tags are included, so the records may be grouped using UsageByTag.
+func (client *Client) UsageReport(ctx context.Context, start, end time.Time, by func(*UsageRecord) string) ([]UsageAggregate, error) {
+	includeTags := true
+	req := &ListUsageRecords{
+		StartDate:   start.Format(usageDateLayout),
+		EndDate:     end.Format(usageDateLayout),
+		IncludeTags: &includeTags,
+	}
+
+	records := make([]UsageRecord, 0)
+
+	var err error
+	client.PaginateWithContext(ctx, req, func(item interface{}, e error) bool {
+		if e != nil {
+			err = e
+			return false
+		}
+		records = append(records, *item.(*UsageRecord))
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return AggregateUsage(records, by), nil
+}
+
+func (*ListUsageRecords) name() string {
+	return "listUsageRecords"
+}
+
+func (*ListUsageRecords) description() string {
+	return "Lists usage records for accounts"
+}
+
+func (*ListUsageRecords) response() interface{} {
+	return new(ListUsageRecordsResponse)
+}
+
+// SetPage sets the current page
+func (ls *ListUsageRecords) SetPage(page int) {
+	ls.Page = page
+}
+
+// SetPageSize sets the page size
+func (ls *ListUsageRecords) SetPageSize(pageSize int) {
+	ls.PageSize = pageSize
+}
+
+func (*ListUsageRecords) each(resp interface{}, callback IterateItemFunc) {
+	records, ok := resp.(*ListUsageRecordsResponse)
+	if !ok {
+		callback(nil, fmt.Errorf("wrong type. ListUsageRecordsResponse expected, got %T", resp))
+		return
+	}
+
+	for i := range records.UsageRecord {
+		if !callback(&records.UsageRecord[i], nil) {
+			break
+		}
+	}
+}
+
+func (*ListUsageTypes) name() string {
+	return "listUsageTypes"
+}
+
+func (*ListUsageTypes) description() string {
+	return "List Usage Types"
+}
+
+func (*ListUsageTypes) response() interface{} {
+	return new(ListUsageTypesResponse)
+}
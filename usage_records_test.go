@@ -0,0 +1,157 @@
+package egoscale
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+)
+
+func TestListUsageRecords(t *testing.T) {
+	req := &ListUsageRecords{}
+	if req.name() != "listUsageRecords" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.response().(*ListUsageRecordsResponse)
+}
+
+func TestListUsageTypes(t *testing.T) {
+	req := &ListUsageTypes{}
+	if req.name() != "listUsageTypes" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.response().(*ListUsageTypesResponse)
+}
+
+func TestUsageTypeString(t *testing.T) {
+	if VolumeUsage.String() != "VolumeUsage" {
+		t.Errorf("bad name, got %q", VolumeUsage.String())
+	}
+	if VMSnapshotUsage.String() != "VMSnapshotUsage" {
+		t.Errorf("bad name, got %q", VMSnapshotUsage.String())
+	}
+	if UsageType(42).String() != "UsageType(42)" {
+		t.Errorf("bad name, got %q", UsageType(42).String())
+	}
+}
+
+func TestAggregateUsage(t *testing.T) {
+	records := []UsageRecord{
+		{Account: "b", UsageID: "vm", UsageType: RunningVM, RawUsage: 24},
+		{Account: "a", UsageID: "vol", UsageType: VolumeUsage, RawUsage: 10, Size: 10 << 30},
+		{Account: "a", UsageID: "vol", UsageType: VolumeUsage, RawUsage: 14, Size: 10 << 30},
+		{Account: "a", UsageID: "ip", UsageType: IPAddressUsage, RawUsage: 24},
+		{Account: "c", UsageID: "net", UsageType: NetworkBytesSent, RawUsage: 1 << 20},
+	}
+
+	aggregates := AggregateUsage(records, UsageByAccount)
+	if len(aggregates) != 4 {
+		t.Fatalf("four aggregates were expected, got %d", len(aggregates))
+	}
+
+	if aggregates[0].Key != "a" || aggregates[0].UsageType != IPAddressUsage {
+		t.Errorf("bad order, got %#v", aggregates[0])
+	}
+
+	volume := aggregates[1]
+	if volume.UsageType != VolumeUsage || volume.Records != 2 || volume.Hours != 24 || volume.GBHours != 240 {
+		t.Errorf("bad volume aggregate, got %#v", volume)
+	}
+
+	if aggregates[2].Key != "b" || aggregates[2].Hours != 24 || aggregates[2].GBHours != 0 {
+		t.Errorf("bad running vm aggregate, got %#v", aggregates[2])
+	}
+
+	if aggregates[3].Bytes != 1<<20 || aggregates[3].Hours != 0 {
+		t.Errorf("bad network aggregate, got %#v", aggregates[3])
+	}
+}
+
+func TestAggregateUsageByTag(t *testing.T) {
+	records := []UsageRecord{
+		{UsageType: RunningVM, RawUsage: 1, Tags: []ResourceTag{{Key: "team", Value: "blue"}}},
+		{UsageType: RunningVM, RawUsage: 2, Tags: []ResourceTag{{Key: "team", Value: "blue"}}},
+		{UsageType: RunningVM, RawUsage: 4},
+	}
+
+	aggregates := AggregateUsage(records, UsageByTag("team"))
+	if len(aggregates) != 2 {
+		t.Fatalf("two aggregates were expected, got %d", len(aggregates))
+	}
+
+	if aggregates[0].Key != "" || aggregates[0].Hours != 4 {
+		t.Errorf("bad untagged aggregate, got %#v", aggregates[0])
+	}
+
+	if aggregates[1].Key != "blue" || aggregates[1].Hours != 3 {
+		t.Errorf("bad tagged aggregate, got %#v", aggregates[1])
+	}
+}
+
+func TestWriteUsageCSV(t *testing.T) {
+	aggregates := []UsageAggregate{
+		{Key: "vol", UsageType: VolumeUsage, Records: 2, Hours: 24, GBHours: 240.5},
+		{Key: "vm", UsageType: NetworkBytesReceived, Records: 1, Bytes: 1024},
+	}
+
+	buf := new(bytes.Buffer)
+	if err := WriteUsageCSV(buf, aggregates); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "key,usagetype,records,hours,gbhours,bytes\nvol,VolumeUsage,2,24.00,240.50,0\nvm,NetworkBytesReceived,1,0.00,0.00,1024\n"
+	if buf.String() != expected {
+		t.Errorf("bad csv, got %q", buf.String())
+	}
+}
+
+func TestClientUsageReport(t *testing.T) {
+	ts := newServer(response{200, jsonContentType, `
+{"listusagerecordsresponse": {
+	"count": 2,
+	"usagerecord": [
+		{
+			"account": "exoscale",
+			"rawusage": "24",
+			"size": 10737418240,
+			"startdate": "2018-03-01'T'00:00:00+01:00",
+			"enddate": "2018-03-01'T'23:59:59+01:00",
+			"tags": [{"key": "team", "value": "blue"}],
+			"usage": "24 Hrs",
+			"usageid": "4c1a4ba0-7f2b-4a0f-8a2f-3c4b3e6e2d11",
+			"usagetype": 6
+		},
+		{
+			"account": "exoscale",
+			"rawusage": "12.5",
+			"startdate": "2018-03-01'T'00:00:00+01:00",
+			"enddate": "2018-03-01'T'23:59:59+01:00",
+			"usage": "12.5 Hrs",
+			"usageid": "1f2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d",
+			"usagetype": 1,
+			"virtualmachineid": "1f2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+		}
+	]
+}}`})
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+
+	start := time.Date(2018, 3, 1, 0, 0, 0, 0, time.UTC)
+	aggregates, err := cs.UsageReport(context.TODO(), start, start, UsageByTag("team"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(aggregates) != 2 {
+		t.Fatalf("two aggregates were expected, got %d", len(aggregates))
+	}
+
+	if aggregates[0].Key != "" || aggregates[0].UsageType != RunningVM || aggregates[0].Hours != 12.5 {
+		t.Errorf("bad running vm aggregate, got %#v", aggregates[0])
+	}
+
+	if aggregates[1].Key != "blue" || aggregates[1].GBHours != 240 {
+		t.Errorf("bad volume aggregate, got %#v", aggregates[1])
+	}
+}
@@ -0,0 +1,124 @@
+package egoscale
+
+// UsageType represents the kind of a usage record
+//
+// See: https://github.com/apache/cloudstack/blob/master/usage/src/main/java/com/cloud/usage/UsageTypes.java
+type UsageType int
+
+//go:generate stringer -type UsageType
+const (
+	// RunningVM is the running time of a virtual machine, in hours
+	RunningVM UsageType = 1
+	// AllocatedVM is the allocated time of a virtual machine, in hours
+	AllocatedVM UsageType = 2
+	// IPAddressUsage is the allocated time of a public IP address, in hours
+	IPAddressUsage UsageType = 3
+	// NetworkBytesSent is the outgoing traffic, in bytes
+	NetworkBytesSent UsageType = 4
+	// NetworkBytesReceived is the incoming traffic, in bytes
+	NetworkBytesReceived UsageType = 5
+	// VolumeUsage is the allocated time of a volume, in hours
+	VolumeUsage UsageType = 6
+	// TemplateUsage is the storage time of a template, in hours
+	TemplateUsage UsageType = 7
+	// ISOUsage is the storage time of an ISO, in hours
+	ISOUsage UsageType = 8
+	// SnapshotUsage is the storage time of a snapshot, in hours
+	SnapshotUsage UsageType = 9
+	// SecurityGroupUsage is the usage time of a security group, in hours
+	SecurityGroupUsage UsageType = 10
+	// LoadBalancerPolicyUsage is the usage time of a load balancer rule, in hours
+	LoadBalancerPolicyUsage UsageType = 11
+	// PortForwardingRuleUsage is the usage time of a port forwarding rule, in hours
+	PortForwardingRuleUsage UsageType = 12
+	// NetworkOfferingUsage is the usage time of a network offering, in hours
+	NetworkOfferingUsage UsageType = 13
+	// VPNUsersUsage is the usage time of the VPN users, in hours
+	VPNUsersUsage UsageType = 14
+	// VMSnapshotUsage is the storage time of a virtual machine snapshot, in hours
+	VMSnapshotUsage UsageType = 25
+)
+
+// UsageRecord represents the consumption of a resource over a period
+type UsageRecord struct {
+	Account          string        `json:"account,omitempty" doc:"the user account name"`
+	AccountID        string        `json:"accountid,omitempty" doc:"the user account Id"`
+	CPUNumber        int64         `json:"cpunumber,omitempty" doc:"number of cpu of resource"`
+	CPUSpeed         int64         `json:"cpuspeed,omitempty" doc:"speed of each cpu of resource"`
+	Description      string        `json:"description,omitempty" doc:"description of the usage record"`
+	Domain           string        `json:"domain,omitempty" doc:"the domain the resource is associated with"`
+	DomainID         string        `json:"domainid,omitempty" doc:"the domain ID"`
+	EndDate          string        `json:"enddate,omitempty" doc:"end date of the usage record"`
+	IsDefault        bool          `json:"isdefault,omitempty" doc:"True if the resource is default"`
+	IsSourceNat      bool          `json:"issourcenat,omitempty" doc:"True if the IPAddress is source NAT"`
+	IsSystem         bool          `json:"issystem,omitempty" doc:"True if the IPAddress is system IP - allocated during vm deploy or lb rule create"`
+	Memory           int64         `json:"memory,omitempty" doc:"memory allocated for the resource"`
+	Name             string        `json:"name,omitempty" doc:"resource or virtual machine name"`
+	NetworkID        string        `json:"networkid,omitempty" doc:"id of the network"`
+	OfferingID       string        `json:"offeringid,omitempty" doc:"offering ID"`
+	RawUsage         float64       `json:"rawusage,omitempty,string" doc:"raw usage in hours"`
+	Size             int64         `json:"size,omitempty" doc:"resource size"`
+	StartDate        string        `json:"startdate,omitempty" doc:"start date of the usage record"`
+	Tags             []ResourceTag `json:"tags,omitempty" doc:"the list of resource tags associated"`
+	TemplateID       string        `json:"templateid,omitempty" doc:"template ID"`
+	Type             string        `json:"type,omitempty" doc:"resource type"`
+	Usage            string        `json:"usage,omitempty" doc:"usage in hours"`
+	UsageID          string        `json:"usageid,omitempty" doc:"id of the resource"`
+	UsageType        UsageType     `json:"usagetype,omitempty" doc:"usage type ID"`
+	VirtualMachineID string        `json:"virtualmachineid,omitempty" doc:"virtual machine ID"`
+	VirtualSize      int64         `json:"virtualsize,omitempty" doc:"virtual size of resource"`
+	ZoneID           string        `json:"zoneid,omitempty" doc:"the zone ID"`
+}
+
+// UsageTypeDescription represents a kind of usage record
+type UsageTypeDescription struct {
+	Description string    `json:"description,omitempty" doc:"description of usage type"`
+	UsageTypeID UsageType `json:"usagetypeid,omitempty" doc:"usage type"`
+}
+
+// ListUsageRecords represents a query for the usage records over a period
+//
+// CloudStack API: https://cloudstack.apache.org/api/apidocs-4.10/apis/listUsageRecords.html
+type ListUsageRecords struct {
+	EndDate     string    `json:"enddate" doc:"End date range for usage record query (use format \"yyyy-MM-dd\" or the new format \"yyyy-MM-dd HH:mm:ss\", e.g. startDate=2015-01-01 or startdate=2015-01-01 10:30:00)."`
+	StartDate   string    `json:"startdate" doc:"Start date range for usage record query (use format \"yyyy-MM-dd\" or the new format \"yyyy-MM-dd HH:mm:ss\", e.g. startDate=2015-01-01 or startdate=2015-01-01 11:00:00)."`
+	Account     string    `json:"account,omitempty" doc:"List usage records for the specified user."`
+	AccountID   string    `json:"accountid,omitempty" doc:"List usage records for the specified account"`
+	DomainID    string    `json:"domainid,omitempty" doc:"List usage records for the specified domain."`
+	IncludeTags *bool     `json:"includetags,omitempty" doc:"Flag to enable display of Tags for a resource"`
+	Keyword     string    `json:"keyword,omitempty" doc:"List by keyword"`
+	Page        int       `json:"page,omitempty"`
+	PageSize    int       `json:"pagesize,omitempty"`
+	Type        UsageType `json:"type,omitempty" doc:"List usage records for the specified usage type"`
+	UsageID     string    `json:"usageid,omitempty" doc:"List usage records for the specified usage UUID. Can be used only together with TYPE parameter."`
+}
+
+// ListUsageRecordsResponse represents a list of usage records
+type ListUsageRecordsResponse struct {
+	Count       int           `json:"count"`
+	UsageRecord []UsageRecord `json:"usagerecord"`
+}
+
+// ListUsageTypes represents a query for the kinds of usage records
+//
+// CloudStack API: https://cloudstack.apache.org/api/apidocs-4.10/apis/listUsageTypes.html
+type ListUsageTypes struct{}
+
+// ListUsageTypesResponse represents a list of usage types
+type ListUsageTypesResponse struct {
+	Count     int                    `json:"count"`
+	UsageType []UsageTypeDescription `json:"usagetype"`
+}
+
+// UsageAggregate represents the usage records of a group (resource, account, tag, etc.) and of one type
+type UsageAggregate struct {
+	Key       string
+	UsageType UsageType
+	Records   int
+	// Hours is the sum of the raw usages, for the time based usage types
+	Hours float64
+	// GBHours is the sum of the raw usages weighted by the size of the resource (volumes, snapshots)
+	GBHours float64
+	// Bytes is the sum of the raw usages, for the network traffic
+	Bytes float64
+}
@@ -0,0 +1,133 @@
+package egoscale
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+)
+
+// EncodeUserData encodes the data in base64, gzipped beforehand when it makes it smaller
+//
+// An error is returned when the encoded data is longer than maxLength. The
+// requests carrying a large user data are automatically sent using POST.
+func EncodeUserData(data []byte, maxLength int) (string, error) {
+	buf := new(bytes.Buffer)
+	gw, err := gzip.NewWriterLevel(buf, gzip.BestCompression)
+	if err != nil {
+		return "", err
+	}
+	if _, err := gw.Write(data); err != nil {
+		return "", err
+	}
+	if err := gw.Close(); err != nil {
+		return "", err
+	}
+
+	if buf.Len() < len(data) {
+		data = buf.Bytes()
+	}
+
+	userData := base64.StdEncoding.EncodeToString(data)
+	if maxLength > 0 && len(userData) > maxLength {
+		return "", fmt.Errorf("the user data is too long, %d bytes once encoded, the maximum is %d", len(userData), maxLength)
+	}
+
+	return userData, nil
+}
+
+// EncodeUserData encodes the data against the limit of the cloud, see Capabilities
+func (client *Client) EncodeUserData(ctx context.Context, data []byte) (string, error) {
+	capabilities, err := client.Capabilities(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return EncodeUserData(data, capabilities.UserDataMaxLength())
+}
+
+// Encode encodes the base64 / gzipped user data, it's the reverse of Decode
+func (userdata *VirtualMachineUserData) Encode(data string) error {
+	userData, err := EncodeUserData([]byte(data), defaultUserDataMaxLength)
+	if err != nil {
+		return err
+	}
+
+	userdata.UserData = userData
+	return nil
+}
+
+// AddCloudConfig adds a cloud-config YAML document
+func (ci *CloudInit) AddCloudConfig(yaml string) *CloudInit {
+	return ci.AddPart("text/cloud-config", yaml)
+}
+
+// AddShellScript adds a script run at the end of the first boot
+func (ci *CloudInit) AddShellScript(script string) *CloudInit {
+	return ci.AddPart("text/x-shellscript", script)
+}
+
+// AddIncludeURL adds URLs whose content is read and processed as user data
+func (ci *CloudInit) AddIncludeURL(urls ...string) *CloudInit {
+	return ci.AddPart("text/x-include-url", strings.Join(urls, "\n")+"\n")
+}
+
+// AddPart adds a part of any content type supported by cloud-init
+func (ci *CloudInit) AddPart(contentType, content string) *CloudInit {
+	ci.parts = append(ci.parts, cloudInitPart{
+		contentType: contentType,
+		content:     content,
+	})
+	return ci
+}
+
+// Bytes builds the multipart MIME document
+func (ci *CloudInit) Bytes() ([]byte, error) {
+	if len(ci.parts) == 0 {
+		return nil, fmt.Errorf("a cloud-init document requires at least one part")
+	}
+
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+
+	for i, part := range ci.parts {
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Type", fmt.Sprintf("%s; charset=\"utf-8\"", part.contentType))
+		header.Set("MIME-Version", "1.0")
+		header.Set("Content-Transfer-Encoding", "7bit")
+		header.Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"part-%03d\"", i+1))
+
+		w, err := writer.CreatePart(header)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write([]byte(part.content)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	doc := new(bytes.Buffer)
+	fmt.Fprintf(doc, "Content-Type: multipart/mixed; boundary=\"%s\"\n", writer.Boundary())
+	fmt.Fprintf(doc, "MIME-Version: 1.0\n\n")
+	doc.Write(body.Bytes())
+
+	return doc.Bytes(), nil
+}
+
+// UserData builds the document and encodes it, see EncodeUserData
+func (ci *CloudInit) UserData(maxLength int) (string, error) {
+	data, err := ci.Bytes()
+	if err != nil {
+		return "", err
+	}
+
+	return EncodeUserData(data, maxLength)
+}
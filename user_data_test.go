@@ -0,0 +1,207 @@
+package egoscale
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestEncodeUserData(t *testing.T) {
+	short := "#!/bin/sh\necho hi\n"
+
+	userData, err := EncodeUserData([]byte(short), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if userData != base64.StdEncoding.EncodeToString([]byte(short)) {
+		t.Errorf("a short user data was expected to be left uncompressed, got %q", userData)
+	}
+
+	long := strings.Repeat("#cloud-config\n", 1000)
+
+	userData, err = EncodeUserData([]byte(long), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, _ := base64.StdEncoding.DecodeString(userData)
+	if len(data) < 2 || data[0] != 0x1f || data[1] != 0x8b {
+		t.Errorf("a long user data was expected to be gzipped")
+	}
+
+	decoded, err := (&VirtualMachineUserData{UserData: userData}).Decode()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if decoded != long {
+		t.Errorf("the user data didn't survive the round trip")
+	}
+}
+
+func TestEncodeUserDataTooLong(t *testing.T) {
+	data := make([]byte, 1024)
+	if _, err := rand.Read(data); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := EncodeUserData(data, 1024); err == nil {
+		t.Error("an error was expected")
+	}
+}
+
+func TestVirtualMachineUserDataEncode(t *testing.T) {
+	userdata := &VirtualMachineUserData{}
+	if err := userdata.Encode("#cloud-config\n"); err != nil {
+		t.Fatal(err)
+	}
+
+	decoded, err := userdata.Decode()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if decoded != "#cloud-config\n" {
+		t.Errorf("bad user data, got %q", decoded)
+	}
+}
+
+func TestClientEncodeUserData(t *testing.T) {
+	ts := newServer(
+		response{200, jsonContentType, listCapabilitiesResponse},
+		response{200, jsonContentType, `
+{"listconfigurationsresponse": {
+	"count": 1,
+	"configuration": [
+		{"name": "vm.userdata.max.length", "value": "16"}
+	]
+}}`},
+	)
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+
+	if _, err := cs.EncodeUserData(context.TODO(), []byte("#!/bin/sh\necho hello world\n")); err == nil {
+		t.Error("an error was expected, the limit is 16")
+	}
+}
+
+func TestCloudInit(t *testing.T) {
+	ci := new(CloudInit).
+		AddCloudConfig("#cloud-config\npackages:\n - nginx\n").
+		AddShellScript("#!/bin/sh\necho hi\n").
+		AddIncludeURL("https://example.com/a", "https://example.com/b")
+
+	doc, err := ci.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	header, body := splitMIMEHeader(t, string(doc))
+
+	mediaType, params, err := mime.ParseMediaType(header)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if mediaType != "multipart/mixed" {
+		t.Errorf("multipart/mixed was expected, got %q", mediaType)
+	}
+
+	reader := multipart.NewReader(strings.NewReader(body), params["boundary"])
+
+	expected := []struct {
+		contentType string
+		content     string
+	}{
+		{"text/cloud-config", "#cloud-config\npackages:\n - nginx\n"},
+		{"text/x-shellscript", "#!/bin/sh\necho hi\n"},
+		{"text/x-include-url", "https://example.com/a\nhttps://example.com/b\n"},
+	}
+
+	for _, e := range expected {
+		part, err := reader.NextPart()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		contentType, _, _ := mime.ParseMediaType(part.Header.Get("Content-Type"))
+		if contentType != e.contentType {
+			t.Errorf("%q was expected, got %q", e.contentType, contentType)
+		}
+
+		content, _ := ioutil.ReadAll(part)
+		if string(content) != e.content {
+			t.Errorf("bad content, got %q", content)
+		}
+	}
+
+	if _, err := reader.NextPart(); err == nil {
+		t.Error("three parts were expected")
+	}
+}
+
+func TestCloudInitEmpty(t *testing.T) {
+	if _, err := new(CloudInit).UserData(0); err == nil {
+		t.Error("an error was expected")
+	}
+}
+
+func TestDeployVirtualMachineLargeUserDataUsesPost(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			w.WriteHeader(400)
+			return
+		}
+		w.Header().Set("Content-Type", jsonContentType)
+		w.Write([]byte(`{"deployvirtualmachineresponse": {"jobid": "1", "jobresult": {"virtualmachine": {"id": "1"}}, "jobstatus": 1}}`))
+	}))
+	defer ts.Close()
+
+	script := make([]byte, 4096)
+	if _, err := rand.Read(script); err != nil {
+		t.Fatal(err)
+	}
+
+	userData, err := new(CloudInit).AddShellScript(base64.StdEncoding.EncodeToString(script)).UserData(defaultUserDataMaxLength)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+
+	_, err = cs.Request(&DeployVirtualMachine{
+		ServiceOfferingID: "1",
+		TemplateID:        "1",
+		ZoneID:            "1",
+		UserData:          userData,
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+// splitMIMEHeader returns the content type and the body of a MIME document
+func splitMIMEHeader(t *testing.T, doc string) (string, string) {
+	i := strings.Index(doc, "\n\n")
+	if i < 0 {
+		t.Fatalf("no header found in %q", doc)
+	}
+
+	for _, line := range strings.Split(doc[:i], "\n") {
+		if strings.HasPrefix(line, "Content-Type: ") {
+			return strings.TrimPrefix(line, "Content-Type: "), doc[i+2:]
+		}
+	}
+
+	t.Fatalf("no content type found in %q", doc)
+	return "", ""
+}
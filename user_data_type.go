@@ -0,0 +1,14 @@
+package egoscale
+
+// CloudInit assembles a multipart MIME document out of cloud-init parts
+//
+// See: https://cloudinit.readthedocs.io/en/latest/topics/format.html
+type CloudInit struct {
+	parts []cloudInitPart
+}
+
+// cloudInitPart represents one part of the multipart document
+type cloudInitPart struct {
+	contentType string
+	content     string
+}
@@ -1,5 +1,44 @@
 package egoscale
 
+import (
+	"context"
+	"fmt"
+)
+
+// ResourceType returns the type of the resource
+func (*User) ResourceType() string {
+	return "User"
+}
+
+// ResourceID returns the identifier of the resource
+func (user *User) ResourceID() string {
+	return user.ID
+}
+
+// Delete removes the given user
+func (user *User) Delete(ctx context.Context, client *Client) error {
+	if user.ID == "" {
+		return fmt.Errorf("a User may only be deleted using ID")
+	}
+
+	return client.BooleanRequestWithContext(ctx, &DeleteUser{
+		ID: user.ID,
+	})
+}
+
+// ListRequest builds the ListUsers request
+func (user *User) ListRequest() (ListCommand, error) {
+	req := &ListUsers{
+		Account:  user.Account,
+		DomainID: user.DomainID,
+		ID:       user.ID,
+		State:    user.State,
+		Username: user.UserName,
+	}
+
+	return req, nil
+}
+
 func (*RegisterUserKeys) name() string {
 	return "registerUserKeys"
 }
@@ -48,6 +87,30 @@ func (*ListUsers) response() interface{} {
 	return new(ListUsersResponse)
 }
 
+// SetPage sets the current page
+func (ls *ListUsers) SetPage(page int) {
+	ls.Page = page
+}
+
+// SetPageSize sets the page size
+func (ls *ListUsers) SetPageSize(pageSize int) {
+	ls.PageSize = pageSize
+}
+
+func (*ListUsers) each(resp interface{}, callback IterateItemFunc) {
+	users, ok := resp.(*ListUsersResponse)
+	if !ok {
+		callback(nil, fmt.Errorf("wrong type. ListUsersResponse expected, got %T", resp))
+		return
+	}
+
+	for i := range users.User {
+		if !callback(&users.User[i], nil) {
+			break
+		}
+	}
+}
+
 func (*DeleteUser) name() string {
 	return "deleteUser"
 }
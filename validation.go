@@ -0,0 +1,286 @@
+package egoscale
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	uuidRegexp      = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	exclusiveRegexp = regexp.MustCompile(`(?i)mutually exclusive with (\w+)`)
+)
+
+// ValidationError represents all the violations found on a command before sending it
+type ValidationError struct {
+	Command    string
+	Violations []string
+}
+
+// Error formats the violations into a single error message
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid %s: %s", e.Command, strings.Join(e.Violations, "; "))
+}
+
+// Validator checks the commands before they are sent to CloudStack
+//
+// The checks are based on the struct tags: required fields (no omitempty), UUID
+// syntax of the *ID fields, allowed values (enum) and mutually exclusive fields
+// (as stated in the doc). If the API descriptions are given, the length, type and
+// required flag of each parameter are checked as well.
+type Validator struct {
+	apis map[string]API
+}
+
+// NewValidator creates a validator based on the given API descriptions (see ListAPIs)
+func NewValidator(apis ...API) *Validator {
+	v := &Validator{
+		apis: make(map[string]API, len(apis)),
+	}
+
+	for _, api := range apis {
+		v.apis[strings.ToLower(api.Name)] = api
+	}
+
+	return v
+}
+
+// Validate checks the given command and returns all the violations at once
+func (v *Validator) Validate(command Command) error {
+	violations := checkValues("", command, true)
+
+	if v != nil {
+		if api, ok := v.apis[strings.ToLower(command.name())]; ok {
+			// the missing required fields may have been reported by both checks
+			reported := make(map[string]bool, len(violations))
+			for _, violation := range violations {
+				reported[violation] = true
+			}
+			for _, violation := range checkParams(command, api.Params) {
+				if !reported[violation] {
+					reported[violation] = true
+					violations = append(violations, violation)
+				}
+			}
+		}
+	}
+
+	if len(violations) > 0 {
+		return &ValidationError{
+			Command:    command.name(),
+			Violations: violations,
+		}
+	}
+
+	return nil
+}
+
+// checkValues walks through the struct and applies the struct tags based checks
+func checkValues(prefix string, value interface{}, required bool) []string {
+	violations := make([]string, 0)
+
+	val := reflect.ValueOf(value)
+	for val.Kind() == reflect.Ptr {
+		if val.IsNil() {
+			return violations
+		}
+		val = val.Elem()
+	}
+
+	if val.Kind() != reflect.Struct {
+		return violations
+	}
+
+	typeof := val.Type()
+	names := make(map[string]reflect.Value, typeof.NumField())
+	exclusives := make(map[string]string)
+
+	for i := 0; i < typeof.NumField(); i++ {
+		field := typeof.Field(i)
+		json, ok := field.Tag.Lookup("json")
+		if !ok {
+			continue
+		}
+
+		n, req := ExtractJSONTag(field.Name, json)
+		name := prefix + n
+		fieldValue := val.Field(i)
+		names[strings.ToLower(n)] = fieldValue
+
+		if isEmptyParam(fieldValue) {
+			if required && req && fieldValue.Kind() != reflect.Bool {
+				violations = append(violations, fmt.Sprintf("%s is required", name))
+			}
+			continue
+		}
+
+		if isUUIDField(field) {
+			for _, id := range stringValues(fieldValue) {
+				if !uuidRegexp.MatchString(id) {
+					violations = append(violations, fmt.Sprintf("%s is not a valid UUID, got %q", name, id))
+				}
+			}
+		}
+
+		if enum, ok := field.Tag.Lookup("enum"); ok {
+			for _, s := range stringValues(fieldValue) {
+				if !inEnum(s, enum) {
+					violations = append(violations, fmt.Sprintf("%s must be one of %s, got %q", name, enum, s))
+				}
+			}
+		}
+
+		if doc, ok := field.Tag.Lookup("doc"); ok {
+			if m := exclusiveRegexp.FindStringSubmatch(doc); m != nil {
+				exclusives[strings.ToLower(n)] = strings.ToLower(m[1])
+			}
+		}
+
+		switch fieldValue.Kind() {
+		case reflect.Struct:
+			violations = append(violations, checkValues(name+".", fieldValue.Interface(), required)...)
+		case reflect.Slice:
+			if fieldValue.Type().Elem().Kind() == reflect.Struct || fieldValue.Type().Elem().Kind() == reflect.Ptr {
+				for j := 0; j < fieldValue.Len(); j++ {
+					p := fmt.Sprintf("%s[%d].", name, j)
+					violations = append(violations, checkValues(p, fieldValue.Index(j).Interface(), required)...)
+				}
+			}
+		}
+	}
+
+	// both sides of the exclusion usually document it, report it once
+	pairs := make([][2]string, 0, len(exclusives))
+	seen := make(map[[2]string]bool)
+	for a, b := range exclusives {
+		other, ok := names[b]
+		if !ok || isEmptyParam(other) {
+			continue
+		}
+		pair := [2]string{a, b}
+		if a > b {
+			pair = [2]string{b, a}
+		}
+		if !seen[pair] {
+			seen[pair] = true
+			pairs = append(pairs, pair)
+		}
+	}
+
+	// the map order is random, the violations must not be
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i][0] != pairs[j][0] {
+			return pairs[i][0] < pairs[j][0]
+		}
+		return pairs[i][1] < pairs[j][1]
+	})
+	for _, pair := range pairs {
+		violations = append(violations, fmt.Sprintf("%s%s and %s%s are mutually exclusive", prefix, pair[0], prefix, pair[1]))
+	}
+
+	return violations
+}
+
+// checkParams verifies the values that would be sent against the API description
+func checkParams(command Command, params []APIParam) []string {
+	violations := make([]string, 0)
+
+	values := url.Values{}
+	// the missing required fields were already reported by checkValues
+	prepareValues("", &values, command) // nolint: errcheck
+	if hookReq, ok := command.(onBeforeHook); ok {
+		hookReq.onBeforeSend(&values) // nolint: errcheck
+	}
+
+	for _, param := range params {
+		name := strings.ToLower(param.Name)
+		value, ok := lookupParam(values, name)
+		if !ok {
+			if param.Required {
+				violations = append(violations, fmt.Sprintf("%s is required", name))
+			}
+			continue
+		}
+
+		if param.Length > 0 && int64(len(value)) > param.Length {
+			violations = append(violations, fmt.Sprintf("%s is too long, %d > %d", name, len(value), param.Length))
+		}
+
+		switch strings.ToLower(param.Type) {
+		case "uuid":
+			if !uuidRegexp.MatchString(value) {
+				violations = append(violations, fmt.Sprintf("%s is not a valid UUID, got %q", name, value))
+			}
+		case "integer", "long", "short":
+			if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+				violations = append(violations, fmt.Sprintf("%s is not a valid %s, got %q", name, param.Type, value))
+			}
+		case "boolean":
+			if _, err := strconv.ParseBool(value); err != nil {
+				violations = append(violations, fmt.Sprintf("%s is not a valid boolean, got %q", name, value))
+			}
+		}
+	}
+
+	return violations
+}
+
+// lookupParam finds a value by its name, lists and maps are seen as a whole
+func lookupParam(values url.Values, name string) (string, bool) {
+	for k, v := range values {
+		key := strings.ToLower(k)
+		if key == name {
+			return v[0], true
+		}
+		if strings.HasPrefix(key, name+"[") {
+			return "", true
+		}
+	}
+	return "", false
+}
+
+// isUUIDField tells whether the field is expected to contain UUID(s)
+func isUUIDField(field reflect.StructField) bool {
+	// the custom ID is free text
+	if field.Name == "CustomID" {
+		return false
+	}
+
+	switch field.Type.Kind() {
+	case reflect.String:
+		return strings.HasSuffix(field.Name, "ID")
+	case reflect.Slice:
+		return field.Type.Elem().Kind() == reflect.String && strings.HasSuffix(field.Name, "IDs")
+	}
+	return false
+}
+
+// stringValues returns the string or strings of the value
+func stringValues(val reflect.Value) []string {
+	switch val.Kind() {
+	case reflect.String:
+		return []string{val.String()}
+	case reflect.Slice:
+		if val.Type().Elem().Kind() == reflect.String {
+			values := make([]string, val.Len())
+			for i := range values {
+				values[i] = val.Index(i).String()
+			}
+			return values
+		}
+	}
+	return nil
+}
+
+func inEnum(value, enum string) bool {
+	for _, e := range strings.Split(enum, ",") {
+		if strings.EqualFold(value, e) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,197 @@
+package egoscale
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestValidatorRequired(t *testing.T) {
+	v := NewValidator()
+
+	err := v.Validate(&DeployVirtualMachine{})
+	if err == nil {
+		t.Fatal("an error was expected")
+	}
+
+	e, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("ValidationError expected, got %T", err)
+	}
+
+	if e.Command != "deployVirtualMachine" {
+		t.Errorf("bad command name, got %q", e.Command)
+	}
+
+	// serviceofferingid, templateid and zoneid
+	if len(e.Violations) != 3 {
+		t.Errorf("three violations were expected, got %#v", e.Violations)
+	}
+}
+
+func TestValidatorUUID(t *testing.T) {
+	v := NewValidator(API{
+		Name: "deployVirtualMachine",
+		Params: []APIParam{
+			{Name: "serviceofferingid", Type: "uuid", Required: true},
+			{Name: "templateid", Type: "uuid", Required: true},
+			{Name: "zoneid", Type: "uuid", Required: true},
+			{Name: "customid", Type: "string"},
+		},
+	})
+
+	req := &DeployVirtualMachine{
+		ServiceOfferingID: "71004023-bb72-4a97-b1e9-bc66dfce9470",
+		TemplateID:        "78c2cbe6-8e11-4722-b01f-bf06f4e28108",
+		ZoneID:            "1128bd56-b4d9-4ac6-a7b9-c715b187ce11",
+		CustomID:          "not an uuid",
+	}
+
+	if err := v.Validate(req); err != nil {
+		t.Error(err)
+	}
+
+	req.ZoneID = "ch-gva-2"
+
+	err := v.Validate(req)
+	if err == nil {
+		t.Fatal("an error was expected")
+	}
+
+	e := err.(*ValidationError)
+	if len(e.Violations) != 1 {
+		t.Errorf("one violation was expected, got %#v", e.Violations)
+	}
+
+	// without the API description, the *ID fields are UUIDs
+	err = NewValidator().Validate(req)
+	if err == nil {
+		t.Fatal("an error was expected")
+	}
+
+	e = err.(*ValidationError)
+	if len(e.Violations) != 1 {
+		t.Errorf("one violation was expected, got %#v", e.Violations)
+	}
+}
+
+func TestValidatorRequiredOnce(t *testing.T) {
+	v := NewValidator(API{
+		Name: "deployVirtualMachine",
+		Params: []APIParam{
+			{Name: "serviceofferingid", Type: "uuid", Required: true},
+			{Name: "templateid", Type: "uuid", Required: true},
+			{Name: "zoneid", Type: "uuid", Required: true},
+		},
+	})
+
+	err := v.Validate(&DeployVirtualMachine{})
+	if err == nil {
+		t.Fatal("an error was expected")
+	}
+
+	e := err.(*ValidationError)
+	if len(e.Violations) != 3 {
+		t.Errorf("three violations were expected, got %#v", e.Violations)
+	}
+}
+
+func TestValidatorEnum(t *testing.T) {
+	v := NewValidator()
+
+	req := &AuthorizeSecurityGroupIngress{
+		Protocol: "ICMPv6",
+	}
+	if err := v.Validate(req); err != nil {
+		t.Error(err)
+	}
+
+	req.Protocol = "sctp"
+	err := v.Validate(req)
+	if err == nil {
+		t.Fatal("an error was expected")
+	}
+
+	if !strings.Contains(err.Error(), "protocol must be one of") {
+		t.Errorf("bad error message, got %q", err.Error())
+	}
+}
+
+func TestValidatorMutuallyExclusive(t *testing.T) {
+	v := NewValidator()
+
+	req := &DeployVirtualMachine{
+		ServiceOfferingID:  "71004023-bb72-4a97-b1e9-bc66dfce9470",
+		TemplateID:         "78c2cbe6-8e11-4722-b01f-bf06f4e28108",
+		ZoneID:             "1128bd56-b4d9-4ac6-a7b9-c715b187ce11",
+		SecurityGroupIDs:   []string{"5f3fc7b3-a3c3-4d05-a3b0-e2be7b8dd5d1"},
+		SecurityGroupNames: []string{"default"},
+		DiskOfferingID:     "b4d6a4a2-3a3b-4bf3-8f3a-01ed0c2c6e2d",
+		Size:               10,
+	}
+
+	err := v.Validate(req)
+	if err == nil {
+		t.Fatal("an error was expected")
+	}
+
+	e := err.(*ValidationError)
+	if len(e.Violations) != 2 {
+		t.Fatalf("two violations were expected, got %#v", e.Violations)
+	}
+
+	// the order must not depend on the map iteration
+	for i := 0; i < 20; i++ {
+		err := v.Validate(req).(*ValidationError)
+		if !reflect.DeepEqual(err.Violations, e.Violations) {
+			t.Fatalf("the violations order changed, got %#v then %#v", e.Violations, err.Violations)
+		}
+	}
+
+	if e.Violations[0] > e.Violations[1] {
+		t.Errorf("the violations were expected to be sorted, got %#v", e.Violations)
+	}
+}
+
+func TestValidatorAPIParams(t *testing.T) {
+	v := NewValidator(API{
+		Name: "createSecurityGroup",
+		Params: []APIParam{
+			{Name: "name", Type: "string", Length: 10, Required: true},
+			{Name: "description", Type: "string", Length: 255, Required: true},
+			{Name: "domainid", Type: "uuid"},
+		},
+	})
+
+	req := &CreateSecurityGroup{
+		Name: "way too long name",
+	}
+
+	err := v.Validate(req)
+	if err == nil {
+		t.Fatal("an error was expected")
+	}
+
+	e := err.(*ValidationError)
+	if len(e.Violations) != 2 {
+		t.Errorf("two violations were expected, got %#v", e.Violations)
+	}
+}
+
+func TestValidatorClient(t *testing.T) {
+	cs := NewClient("http://exoscale.local/", "KEY", "SECRET")
+	cs.Validator = NewValidator()
+
+	_, err := cs.Payload(&ListTemplates{TemplateFilter: "mine"})
+	if err == nil {
+		t.Fatal("an error was expected")
+	}
+
+	if _, ok := err.(*ValidationError); !ok {
+		t.Errorf("ValidationError expected, got %T", err)
+	}
+
+	if _, err := cs.CreateRecord("example.org", DNSRecord{RecordType: "BOGUS"}); err == nil {
+		t.Errorf("an error was expected")
+	}
+}
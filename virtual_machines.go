@@ -4,11 +4,15 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
 	"encoding/base64"
 	"fmt"
 	"io/ioutil"
 	"net"
 	"net/url"
+
+	"golang.org/x/crypto/ssh"
 )
 
 // ResourceType returns the type of the resource
@@ -16,6 +20,11 @@ func (*VirtualMachine) ResourceType() string {
 	return "UserVM"
 }
 
+// ResourceID returns the identifier of the resource
+func (vm *VirtualMachine) ResourceID() string {
+	return vm.ID
+}
+
 // Delete destroys the VM
 func (vm *VirtualMachine) Delete(ctx context.Context, client *Client) error {
 	_, err := client.RequestWithContext(ctx, &DestroyVirtualMachine{
@@ -27,7 +36,7 @@ func (vm *VirtualMachine) Delete(ctx context.Context, client *Client) error {
 
 // ListRequest builds the ListVirtualMachines request
 func (vm *VirtualMachine) ListRequest() (ListCommand, error) {
-	// XXX: AffinityGroupID, SecurityGroupID, Tags
+	// XXX: AffinityGroupID, SecurityGroupID
 
 	req := &ListVirtualMachines{
 		Account:    vm.Account,
@@ -36,6 +45,7 @@ func (vm *VirtualMachine) ListRequest() (ListCommand, error) {
 		ID:         vm.ID,
 		Name:       vm.Name,
 		State:      vm.State,
+		Tags:       tagFilter(vm.Tags),
 		TemplateID: vm.TemplateID,
 		ZoneID:     vm.ZoneID,
 	}
@@ -396,3 +406,95 @@ func (userdata *VirtualMachineUserData) Decode() (string, error) {
 	}
 	return string(str), nil
 }
+
+// Decrypt decrypts the password using the private key of the SSH key pair of the VM
+//
+// The key is a PEM encoded RSA key, either PKCS#1, PKCS#8 or in the OpenSSH
+// format, the default of ssh-keygen.
+func (pwd *Password) Decrypt(privateKeyPEM []byte) (string, error) {
+	return pwd.DecryptWithPassphrase(privateKeyPEM, nil)
+}
+
+// DecryptWithPassphrase decrypts the password using a passphrase protected private key
+//
+// Both the OpenSSH format and the legacy PEM encryption (ssh-keygen -m PEM) are
+// supported, not the encrypted PKCS#8.
+func (pwd *Password) DecryptWithPassphrase(privateKeyPEM, passphrase []byte) (string, error) {
+	if pwd.EncryptedPassword == "" {
+		return "", fmt.Errorf("no encrypted password to decrypt")
+	}
+
+	key, err := parseRSAPrivateKey(privateKeyPEM, passphrase)
+	if err != nil {
+		return "", err
+	}
+
+	encrypted, err := base64.StdEncoding.DecodeString(pwd.EncryptedPassword)
+	if err != nil {
+		return "", err
+	}
+
+	password, err := rsa.DecryptPKCS1v15(rand.Reader, key, encrypted)
+	if err != nil {
+		return "", err
+	}
+
+	return string(password), nil
+}
+
+// parseRSAPrivateKey reads a PEM encoded RSA private key, the passphrase is only used if the key is protected
+func parseRSAPrivateKey(privateKeyPEM, passphrase []byte) (*rsa.PrivateKey, error) {
+	key, err := ssh.ParseRawPrivateKey(privateKeyPEM)
+	if _, ok := err.(*ssh.PassphraseMissingError); ok {
+		if len(passphrase) == 0 {
+			return nil, fmt.Errorf("the private key is protected by a passphrase")
+		}
+		key, err = ssh.ParseRawPrivateKeyWithPassphrase(privateKeyPEM, passphrase)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("a RSA private key was expected, got %T", key)
+	}
+	return rsaKey, nil
+}
+
+// ResetAndFetchPassword resets the password of the VM and decrypts the new one
+//
+// The VM must be stopped and its template must be password enabled, e.g. the
+// Windows templates. The PEM encoded private key is the one of the SSH key pair
+// the VM was deployed with, see ResetAndFetchPasswordWithPassphrase when it is
+// protected by a passphrase.
+func (client *Client) ResetAndFetchPassword(ctx context.Context, vmID string, privateKeyPEM []byte) (string, error) {
+	return client.ResetAndFetchPasswordWithPassphrase(ctx, vmID, privateKeyPEM, nil)
+}
+
+// ResetAndFetchPasswordWithPassphrase resets the password of the VM and decrypts the new one using a passphrase protected private key
+//
+// A VM deployed without any SSH key pair gets its new password in plaintext, it
+// is returned as is and no key is needed.
+func (client *Client) ResetAndFetchPasswordWithPassphrase(ctx context.Context, vmID string, privateKeyPEM, passphrase []byte) (string, error) {
+	resp, err := client.RequestWithContext(ctx, &ResetPasswordForVirtualMachine{
+		ID: vmID,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	vm := resp.(*VirtualMachine)
+	if vm.KeyPair == "" && vm.Password != "" {
+		return vm.Password, nil
+	}
+
+	resp, err = client.RequestWithContext(ctx, &GetVMPassword{
+		ID: vmID,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return resp.(*Password).DecryptWithPassphrase(privateKeyPEM, passphrase)
+}
@@ -78,7 +78,7 @@ type IPToNetwork struct {
 
 // Password represents an encrypted password
 //
-// TODO: method to decrypt it, https://cwiki.apache.org/confluence/pages/viewpage.action?pageId=34014652
+// See: https://cwiki.apache.org/confluence/pages/viewpage.action?pageId=34014652
 type Password struct {
 	EncryptedPassword string `json:"encryptedpassword"`
 }
@@ -91,7 +91,7 @@ type VirtualMachineUserData struct {
 
 // DeployVirtualMachine (Async) represents the machine creation
 //
-// Regarding the UserData field, the client is responsible to base64 (and probably gzip) it. Doing it within this library would make the integration with other tools, e.g. Terraform harder.
+// Regarding the UserData field, the client is responsible to base64 (and probably gzip) it, see EncodeUserData and CloudInit. Doing it implicitly within this library would make the integration with other tools, e.g. Terraform harder.
 //
 // CloudStack API: https://cloudstack.apache.org/api/apidocs-4.10/apis/deployVirtualMachine.html
 type DeployVirtualMachine struct {
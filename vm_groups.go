@@ -1,5 +1,33 @@
 package egoscale
 
+import (
+	"context"
+	"fmt"
+)
+
+// Delete removes the given instance group
+func (ig *InstanceGroup) Delete(ctx context.Context, client *Client) error {
+	if ig.ID == "" {
+		return fmt.Errorf("an InstanceGroup may only be deleted using ID")
+	}
+
+	return client.BooleanRequestWithContext(ctx, &DeleteInstanceGroup{
+		ID: ig.ID,
+	})
+}
+
+// ListRequest builds the ListInstanceGroups request
+func (ig *InstanceGroup) ListRequest() (ListCommand, error) {
+	req := &ListInstanceGroups{
+		Account:  ig.Account,
+		DomainID: ig.DomainID,
+		ID:       ig.ID,
+		Name:     ig.Name,
+	}
+
+	return req, nil
+}
+
 func (*CreateInstanceGroup) name() string {
 	return "createInstanceGroup"
 }
@@ -47,3 +75,27 @@ func (*ListInstanceGroups) description() string {
 func (*ListInstanceGroups) response() interface{} {
 	return new(ListInstanceGroupsResponse)
 }
+
+// SetPage sets the current page
+func (ls *ListInstanceGroups) SetPage(page int) {
+	ls.Page = page
+}
+
+// SetPageSize sets the page size
+func (ls *ListInstanceGroups) SetPageSize(pageSize int) {
+	ls.PageSize = pageSize
+}
+
+func (*ListInstanceGroups) each(resp interface{}, callback IterateItemFunc) {
+	groups, ok := resp.(*ListInstanceGroupsResponse)
+	if !ok {
+		callback(nil, fmt.Errorf("wrong type. ListInstanceGroupsResponse expected, got %T", resp))
+		return
+	}
+
+	for i := range groups.InstanceGroup {
+		if !callback(&groups.InstanceGroup[i], nil) {
+			break
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package egoscale
+
+import (
+	"context"
+	"fmt"
+)
+
+// ResourceType returns the type of the resource
+func (*VMSnapshot) ResourceType() string {
+	return "VMSnapshot"
+}
+
+// ResourceID returns the identifier of the resource
+func (snapshot *VMSnapshot) ResourceID() string {
+	return snapshot.ID
+}
+
+// Delete removes the given VM snapshot
+func (snapshot *VMSnapshot) Delete(ctx context.Context, client *Client) error {
+	if snapshot.ID == "" {
+		return fmt.Errorf("a VMSnapshot may only be deleted using ID")
+	}
+
+	return client.BooleanRequestWithContext(ctx, &DeleteVMSnapshot{
+		VMSnapshotID: snapshot.ID,
+	})
+}
+
+// ListRequest builds the ListVMSnapshot request
+func (snapshot *VMSnapshot) ListRequest() (ListCommand, error) {
+	req := &ListVMSnapshot{
+		Account:          snapshot.Account,
+		DomainID:         snapshot.DomainID,
+		Name:             snapshot.Name,
+		State:            snapshot.State,
+		Tags:             tagFilter(snapshot.Tags),
+		VirtualMachineID: snapshot.VirtualMachineID,
+		VMSnapshotID:     snapshot.ID,
+	}
+
+	return req, nil
+}
+
+func (*CreateVMSnapshot) name() string {
+	return "createVMSnapshot"
+}
+
+func (*CreateVMSnapshot) description() string {
+	return "Creates snapshot for a vm."
+}
+
+func (*CreateVMSnapshot) asyncResponse() interface{} {
+	return new(VMSnapshot)
+}
+
+func (*ListVMSnapshot) name() string {
+	return "listVMSnapshot"
+}
+
+func (*ListVMSnapshot) description() string {
+	return "List virtual machine snapshot by conditions"
+}
+
+func (*ListVMSnapshot) response() interface{} {
+	return new(ListVMSnapshotResponse)
+}
+
+// SetPage sets the current page
+func (ls *ListVMSnapshot) SetPage(page int) {
+	ls.Page = page
+}
+
+// SetPageSize sets the page size
+func (ls *ListVMSnapshot) SetPageSize(pageSize int) {
+	ls.PageSize = pageSize
+}
+
+func (*ListVMSnapshot) each(resp interface{}, callback IterateItemFunc) {
+	snapshots, ok := resp.(*ListVMSnapshotResponse)
+	if !ok {
+		callback(nil, fmt.Errorf("wrong type. ListVMSnapshotResponse expected, got %T", resp))
+		return
+	}
+
+	for i := range snapshots.VMSnapshot {
+		if !callback(&snapshots.VMSnapshot[i], nil) {
+			break
+		}
+	}
+}
+
+func (*RevertToVMSnapshot) name() string {
+	return "revertToVMSnapshot"
+}
+
+func (*RevertToVMSnapshot) description() string {
+	return "Revert VM from a vmsnapshot."
+}
+
+func (*RevertToVMSnapshot) asyncResponse() interface{} {
+	return new(VirtualMachine)
+}
+
+func (*DeleteVMSnapshot) name() string {
+	return "deleteVMSnapshot"
+}
+
+func (*DeleteVMSnapshot) description() string {
+	return "Deletes a vmsnapshot."
+}
+
+func (*DeleteVMSnapshot) asyncResponse() interface{} {
+	return new(booleanResponse)
+}
@@ -0,0 +1,92 @@
+package egoscale
+
+import (
+	"testing"
+)
+
+func TestVMSnapshot(t *testing.T) {
+	instance := &VMSnapshot{}
+	if instance.ResourceType() != "VMSnapshot" {
+		t.Errorf("ResourceType doesn't match")
+	}
+}
+
+func TestCreateVMSnapshot(t *testing.T) {
+	req := &CreateVMSnapshot{}
+	if req.name() != "createVMSnapshot" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.asyncResponse().(*VMSnapshot)
+}
+
+func TestListVMSnapshot(t *testing.T) {
+	req := &ListVMSnapshot{}
+	if req.name() != "listVMSnapshot" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.response().(*ListVMSnapshotResponse)
+}
+
+func TestRevertToVMSnapshot(t *testing.T) {
+	req := &RevertToVMSnapshot{}
+	if req.name() != "revertToVMSnapshot" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.asyncResponse().(*VirtualMachine)
+}
+
+func TestDeleteVMSnapshot(t *testing.T) {
+	req := &DeleteVMSnapshot{}
+	if req.name() != "deleteVMSnapshot" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.asyncResponse().(*booleanResponse)
+}
+
+func TestListVMSnapshots(t *testing.T) {
+	ts := newServer(response{200, jsonContentType, `
+{"listvmsnapshotresponse": {
+	"count": 2,
+	"vmSnapshot": [
+		{
+			"created": "2018-05-03T09:12:01+0200",
+			"current": false,
+			"displayname": "before-upgrade",
+			"id": "0c5a3a3e-41d4-4b8f-9a5e-5f1c1e0c5c1a",
+			"name": "i-2-246634-VM_VS_20180503071201",
+			"state": "Ready",
+			"type": "Disk",
+			"virtualmachineid": "9ccc3d5b-9dce-4302-a955-24b80b402f88"
+		},
+		{
+			"created": "2018-05-03T10:12:01+0200",
+			"current": true,
+			"displayname": "after-upgrade",
+			"id": "7d2c1f0e-8a3b-4c2d-9e1f-0a1b2c3d4e5f",
+			"name": "i-2-246634-VM_VS_20180503081201",
+			"parent": "0c5a3a3e-41d4-4b8f-9a5e-5f1c1e0c5c1a",
+			"parentName": "before-upgrade",
+			"state": "Ready",
+			"type": "DiskAndMemory",
+			"virtualmachineid": "9ccc3d5b-9dce-4302-a955-24b80b402f88"
+		}
+	]
+}}`})
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+
+	snapshots, err := cs.List(&VMSnapshot{VirtualMachineID: "9ccc3d5b-9dce-4302-a955-24b80b402f88"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(snapshots) != 2 {
+		t.Fatalf("two snapshots were expected, got %d", len(snapshots))
+	}
+
+	snapshot := snapshots[1].(*VMSnapshot)
+	if !snapshot.Current || snapshot.ParentName != "before-upgrade" {
+		t.Errorf("bad snapshot, got %#v", snapshot)
+	}
+}
@@ -0,0 +1,70 @@
+package egoscale
+
+// VMSnapshot represents a snapshot of a whole virtual machine, optionally with its memory
+type VMSnapshot struct {
+	Account          string        `json:"account,omitempty" doc:"the account associated with the disk volume"`
+	Created          string        `json:"created,omitempty" doc:"the create date of the vm snapshot"`
+	Current          bool          `json:"current,omitempty" doc:"indiates if this is current snapshot"`
+	Description      string        `json:"description,omitempty" doc:"the description of the vm snapshot"`
+	DisplayName      string        `json:"displayname,omitempty" doc:"the display name of the vm snapshot"`
+	Domain           string        `json:"domain,omitempty" doc:"the domain associated with the disk volume"`
+	DomainID         string        `json:"domainid,omitempty" doc:"the ID of the domain associated with the disk volume"`
+	ID               string        `json:"id,omitempty" doc:"the ID of the vm snapshot"`
+	Name             string        `json:"name,omitempty" doc:"the name of the vm snapshot"`
+	Parent           string        `json:"parent,omitempty" doc:"the parent ID of the vm snapshot"`
+	ParentName       string        `json:"parentName,omitempty" doc:"the parent displayName of the vm snapshot"`
+	State            string        `json:"state,omitempty" doc:"the state of the vm snapshot"`
+	Tags             []ResourceTag `json:"tags,omitempty" doc:"the list of resource tags associated with the vm snapshot"`
+	Type             string        `json:"type,omitempty" doc:"VM Snapshot type: Disk or DiskAndMemory"`
+	VirtualMachineID string        `json:"virtualmachineid,omitempty" doc:"the vm ID of the vm snapshot"`
+	ZoneID           string        `json:"zoneid,omitempty" doc:"the Zone ID of the vm snapshot"`
+}
+
+// CreateVMSnapshot (Async) creates a snapshot of a virtual machine
+//
+// CloudStack API: https://cloudstack.apache.org/api/apidocs-4.10/apis/createVMSnapshot.html
+type CreateVMSnapshot struct {
+	VirtualMachineID string `json:"virtualmachineid" doc:"The ID of the vm"`
+	Description      string `json:"description,omitempty" doc:"The description of the snapshot"`
+	Name             string `json:"name,omitempty" doc:"The display name of the snapshot"`
+	QuiesceVM        *bool  `json:"quiescevm,omitempty" doc:"quiesce vm if true"`
+	SnapshotMemory   *bool  `json:"snapshotmemory,omitempty" doc:"snapshot memory if true"`
+}
+
+// ListVMSnapshot lists the virtual machine snapshots
+//
+// CloudStack API: https://cloudstack.apache.org/api/apidocs-4.10/apis/listVMSnapshot.html
+type ListVMSnapshot struct {
+	Account          string        `json:"account,omitempty" doc:"list resources by account. Must be used with the domainId parameter."`
+	DomainID         string        `json:"domainid,omitempty" doc:"list only resources belonging to the domain specified"`
+	IsRecursive      *bool         `json:"isrecursive,omitempty" doc:"defaults to false, but if true, lists all resources from the parent specified by the domainId till leaves."`
+	Keyword          string        `json:"keyword,omitempty" doc:"List by keyword"`
+	ListAll          *bool         `json:"listall,omitempty" doc:"If set to false, list only resources belonging to the command's caller; if set to true - list resources that the caller is authorized to see. Default value is false"`
+	Name             string        `json:"name,omitempty" doc:"lists snapshot by snapshot name or display name"`
+	Page             int           `json:"page,omitempty"`
+	PageSize         int           `json:"pagesize,omitempty"`
+	State            string        `json:"state,omitempty" doc:"state of the virtual machine snapshot"`
+	Tags             []ResourceTag `json:"tags,omitempty" doc:"List resources by tags (key/value pairs)"`
+	VirtualMachineID string        `json:"virtualmachineid,omitempty" doc:"the ID of the vm"`
+	VMSnapshotID     string        `json:"vmsnapshotid,omitempty" doc:"The ID of the VM snapshot"`
+}
+
+// ListVMSnapshotResponse represents a list of virtual machine snapshots
+type ListVMSnapshotResponse struct {
+	Count      int          `json:"count"`
+	VMSnapshot []VMSnapshot `json:"vmSnapshot"`
+}
+
+// RevertToVMSnapshot (Async) reverts a virtual machine to the given snapshot
+//
+// CloudStack API: https://cloudstack.apache.org/api/apidocs-4.10/apis/revertToVMSnapshot.html
+type RevertToVMSnapshot struct {
+	VMSnapshotID string `json:"vmsnapshotid" doc:"The ID of the vm snapshot"`
+}
+
+// DeleteVMSnapshot (Async) deletes a virtual machine snapshot
+//
+// CloudStack API: https://cloudstack.apache.org/api/apidocs-4.10/apis/deleteVMSnapshot.html
+type DeleteVMSnapshot struct {
+	VMSnapshotID string `json:"vmsnapshotid" doc:"The ID of the VM snapshot"`
+}
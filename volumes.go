@@ -1,7 +1,10 @@
 package egoscale
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net/url"
 )
 
 // ResourceType returns the type of the resource
@@ -9,12 +12,30 @@ func (*Volume) ResourceType() string {
 	return "Volume"
 }
 
+// ResourceID returns the identifier of the resource
+func (vol *Volume) ResourceID() string {
+	return vol.ID
+}
+
+// Delete removes the given volume, it has to be detached first
+func (vol *Volume) Delete(ctx context.Context, client *Client) error {
+	if vol.ID == "" {
+		return fmt.Errorf("a Volume may only be deleted using ID")
+	}
+
+	return client.BooleanRequestWithContext(ctx, &DeleteVolume{
+		ID: vol.ID,
+	})
+}
+
 // ListRequest builds the ListVolumes request
 func (vol *Volume) ListRequest() (ListCommand, error) {
 	req := &ListVolumes{
 		Account:          vol.Account,
 		DomainID:         vol.DomainID,
+		ID:               vol.ID,
 		Name:             vol.Name,
+		Tags:             tagFilter(vol.Tags),
 		Type:             vol.Type,
 		VirtualMachineID: vol.VirtualMachineID,
 		ZoneID:           vol.ZoneID,
@@ -23,6 +44,86 @@ func (vol *Volume) ListRequest() (ListCommand, error) {
 	return req, nil
 }
 
+func (*CreateVolume) name() string {
+	return "createVolume"
+}
+
+func (*CreateVolume) description() string {
+	return "Creates a disk volume from a disk offering. This disk volume must still be attached to a virtual machine to make use of it."
+}
+
+func (*CreateVolume) asyncResponse() interface{} {
+	return new(Volume)
+}
+
+func (req *CreateVolume) onBeforeSend(params *url.Values) error {
+	// Either DiskOfferingID or SnapshotID must be set
+	if req.DiskOfferingID == "" && req.SnapshotID == "" {
+		return errors.New("either diskofferingid or snapshotid must be set")
+	}
+	return nil
+}
+
+func (*AttachVolume) name() string {
+	return "attachVolume"
+}
+
+func (*AttachVolume) description() string {
+	return "Attaches a disk volume to a virtual machine."
+}
+
+func (*AttachVolume) asyncResponse() interface{} {
+	return new(Volume)
+}
+
+func (*DetachVolume) name() string {
+	return "detachVolume"
+}
+
+func (*DetachVolume) description() string {
+	return "Detaches a disk volume from a virtual machine."
+}
+
+func (*DetachVolume) asyncResponse() interface{} {
+	return new(Volume)
+}
+
+func (*DeleteVolume) name() string {
+	return "deleteVolume"
+}
+
+func (*DeleteVolume) description() string {
+	return "Deletes a detached disk volume."
+}
+
+func (*DeleteVolume) response() interface{} {
+	return new(booleanResponse)
+}
+
+func (*UploadVolume) name() string {
+	return "uploadVolume"
+}
+
+func (*UploadVolume) description() string {
+	return "Uploads a data disk."
+}
+
+func (*UploadVolume) asyncResponse() interface{} {
+	return new(Volume)
+}
+
+func (*ExtractVolume) name() string {
+	return "extractVolume"
+}
+
+func (*ExtractVolume) description() string {
+	return "Extracts volume"
+}
+
+func (*ExtractVolume) asyncResponse() interface{} {
+	return new(ExtractedResource)
+}
+
 func (*ResizeVolume) name() string {
 	return "resizeVolume"
 }
@@ -263,3 +263,148 @@ func TestListVolumeError(t *testing.T) {
 		t.Error("An error was expected")
 	}
 }
+
+func TestCreateVolume(t *testing.T) {
+	req := &CreateVolume{}
+	if req.name() != "createVolume" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.asyncResponse().(*Volume)
+}
+
+func TestAttachVolume(t *testing.T) {
+	req := &AttachVolume{}
+	if req.name() != "attachVolume" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.asyncResponse().(*Volume)
+}
+
+func TestDetachVolume(t *testing.T) {
+	req := &DetachVolume{}
+	if req.name() != "detachVolume" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.asyncResponse().(*Volume)
+}
+
+func TestDeleteVolume(t *testing.T) {
+	req := &DeleteVolume{}
+	if req.name() != "deleteVolume" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.response().(*booleanResponse)
+}
+
+func TestUploadVolume(t *testing.T) {
+	req := &UploadVolume{}
+	if req.name() != "uploadVolume" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.asyncResponse().(*Volume)
+}
+
+func TestExtractVolume(t *testing.T) {
+	req := &ExtractVolume{}
+	if req.name() != "extractVolume" {
+		t.Errorf("API call doesn't match")
+	}
+	_ = req.asyncResponse().(*ExtractedResource)
+}
+
+func TestCreateVolumeWithoutOffering(t *testing.T) {
+	cs := NewClient("http://exoscale.local/", "KEY", "SECRET")
+
+	if _, err := cs.Payload(&CreateVolume{Name: "data"}); err == nil {
+		t.Error("an error was expected")
+	}
+
+	if _, err := cs.Payload(&CreateVolume{Name: "data", SnapshotID: "1"}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCreateAndAttachVolume(t *testing.T) {
+	ts := newServer(response{200, jsonContentType, `
+{"createvolumeresponse": {
+	"id": "56f21d62-45f7-4c8a-92b2-bfa0a1f2b5a4",
+	"jobid": "01ed7adc-8b81-4e33-a0f2-4f55a3b880cd"
+}}`}, response{200, jsonContentType, `
+{"queryasyncjobresultresponse": {
+	"accountid": "b8d15a5c-2e2c-4b0f-9d1b-6c2f3c07e7c1",
+	"cmd": "org.apache.cloudstack.api.command.user.volume.CreateVolumeCmd",
+	"created": "2018-05-02T10:12:41+0200",
+	"jobid": "01ed7adc-8b81-4e33-a0f2-4f55a3b880cd",
+	"jobprocstatus": 0,
+	"jobresult": {
+		"volume": {
+			"account": "test",
+			"created": "2018-05-02T10:12:41+0200",
+			"diskofferingid": "4fb6ab7e-2b0f-4c4b-8b5d-2d0f7b2c8f2d",
+			"id": "56f21d62-45f7-4c8a-92b2-bfa0a1f2b5a4",
+			"name": "data",
+			"size": 53687091200,
+			"state": "Allocated",
+			"type": "DATADISK",
+			"zoneid": "1747ef5e-5451-41fd-9f1a-58913bae9702",
+			"zonename": "ch-gva-2"
+		}
+	},
+	"jobresultcode": 0,
+	"jobresulttype": "object",
+	"jobstatus": 1,
+	"userid": "1c8a4a1e-7b0e-4d25-a5a1-0e6b4b6bc3b6"
+}}`}, response{200, jsonContentType, `
+{"attachvolumeresponse": {
+	"id": "56f21d62-45f7-4c8a-92b2-bfa0a1f2b5a4",
+	"jobid": "8a1d9b8e-9a4a-4e5e-8c61-1c2cb1c1e5f2"
+}}`}, response{200, jsonContentType, `
+{"queryasyncjobresultresponse": {
+	"jobid": "8a1d9b8e-9a4a-4e5e-8c61-1c2cb1c1e5f2",
+	"jobresult": {
+		"volume": {
+			"attached": "2018-05-02T10:12:52+0200",
+			"deviceid": 1,
+			"id": "56f21d62-45f7-4c8a-92b2-bfa0a1f2b5a4",
+			"name": "data",
+			"state": "Ready",
+			"type": "DATADISK",
+			"virtualmachineid": "9ccc3d5b-9dce-4302-a955-24b80b402f88",
+			"vmstate": "Running"
+		}
+	},
+	"jobresultcode": 0,
+	"jobresulttype": "object",
+	"jobstatus": 1
+}}`})
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "KEY", "SECRET")
+
+	resp, err := cs.Request(&CreateVolume{
+		Name:           "data",
+		DiskOfferingID: "4fb6ab7e-2b0f-4c4b-8b5d-2d0f7b2c8f2d",
+		ZoneID:         "1747ef5e-5451-41fd-9f1a-58913bae9702",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	volume := resp.(*Volume)
+	if volume.State != "Allocated" {
+		t.Errorf("Allocated state was expected, got %q", volume.State)
+	}
+
+	resp, err = cs.Request(&AttachVolume{
+		ID:               volume.ID,
+		VirtualMachineID: "9ccc3d5b-9dce-4302-a955-24b80b402f88",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	volume = resp.(*Volume)
+	if volume.DeviceID != 1 {
+		t.Errorf("device 1 was expected, got %d", volume.DeviceID)
+	}
+}
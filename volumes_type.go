@@ -56,6 +56,92 @@ type Volume struct {
 	ZoneName                   string        `json:"zonename,omitempty" doc:"name of the availability zone"`
 }
 
+// CreateVolume (Async) creates a disk volume from a disk offering or a snapshot
+//
+// CloudStack API: https://cloudstack.apache.org/api/apidocs-4.10/apis/createVolume.html
+type CreateVolume struct {
+	Account          string `json:"account,omitempty" doc:"the account associated with the disk volume. Must be used with the domainId parameter."`
+	CustomID         string `json:"customid,omitempty" doc:"an optional field, in case you want to set a custom id to the resource. Allowed to Root Admins only"`
+	DiskOfferingID   string `json:"diskofferingid,omitempty" doc:"the ID of the disk offering. Either diskOfferingId or snapshotId must be passed in."`
+	DisplayVolume    *bool  `json:"displayvolume,omitempty" doc:"an optional field, whether to display the volume to the end user or not."`
+	DomainID         string `json:"domainid,omitempty" doc:"the domain ID associated with the disk offering. If used with the account parameter returns the disk volume associated with the account for the specified domain."`
+	MaxIops          int64  `json:"maxiops,omitempty" doc:"max iops"`
+	MinIops          int64  `json:"miniops,omitempty" doc:"min iops"`
+	Name             string `json:"name,omitempty" doc:"the name of the disk volume"`
+	Size             int64  `json:"size,omitempty" doc:"Arbitrary volume size (in GB)"`
+	SnapshotID       string `json:"snapshotid,omitempty" doc:"the snapshot ID for the disk volume. Either diskOfferingId or snapshotId must be passed in."`
+	VirtualMachineID string `json:"virtualmachineid,omitempty" doc:"the ID of the virtual machine; to be used with snapshot Id, VM to which the volume gets attached after creation"`
+	ZoneID           string `json:"zoneid,omitempty" doc:"the ID of the availability zone"`
+}
+
+// AttachVolume (Async) attaches a disk volume to a virtual machine
+//
+// CloudStack API: https://cloudstack.apache.org/api/apidocs-4.10/apis/attachVolume.html
+type AttachVolume struct {
+	ID               string `json:"id" doc:"the ID of the disk volume"`
+	VirtualMachineID string `json:"virtualmachineid" doc:"the ID of the virtual machine"`
+	DeviceID         int64  `json:"deviceid,omitempty" doc:"the ID of the device to map the volume to within the guest OS. If no deviceId is passed in, the next available deviceId will be chosen."`
+}
+
+// DetachVolume (Async) detaches a disk volume from a virtual machine
+//
+// CloudStack API: https://cloudstack.apache.org/api/apidocs-4.10/apis/detachVolume.html
+type DetachVolume struct {
+	ID               string `json:"id,omitempty" doc:"the ID of the disk volume"`
+	DeviceID         int64  `json:"deviceid,omitempty" doc:"the device ID on the virtual machine where volume is detached from"`
+	VirtualMachineID string `json:"virtualmachineid,omitempty" doc:"the ID of the virtual machine where the volume is detached from"`
+}
+
+// DeleteVolume deletes a detached disk volume
+//
+// CloudStack API: https://cloudstack.apache.org/api/apidocs-4.10/apis/deleteVolume.html
+type DeleteVolume struct {
+	ID string `json:"id" doc:"The ID of the disk volume"`
+}
+
+// UploadVolume (Async) uploads a data disk from an URL
+//
+// CloudStack API: https://cloudstack.apache.org/api/apidocs-4.10/apis/uploadVolume.html
+type UploadVolume struct {
+	Format         string `json:"format" doc:"the format for the volume. Possible values include QCOW2, OVA, and VHD." enum:"QCOW2,OVA,VHD,VHDX,RAW"`
+	Name           string `json:"name" doc:"the name of the volume"`
+	URL            string `json:"url" doc:"the URL of where the volume is hosted. Possible URL include http:// and https://"`
+	ZoneID         string `json:"zoneid" doc:"the ID of the zone the volume is to be hosted on"`
+	Account        string `json:"account,omitempty" doc:"an optional accountName. Must be used with domainId."`
+	Checksum       string `json:"checksum,omitempty" doc:"the checksum value of this volume."`
+	DiskOfferingID string `json:"diskofferingid,omitempty" doc:"the ID of the disk offering. This must be a custom sized offering since during uploadVolume volume size is unknown."`
+	DomainID       string `json:"domainid,omitempty" doc:"an optional domainId. If the account parameter is used, domainId must also be used."`
+	ImageStoreUUID string `json:"imagestoreuuid,omitempty" doc:"Image store uuid"`
+}
+
+// ExtractVolume (Async) builds a download URL for a volume
+//
+// CloudStack API: https://cloudstack.apache.org/api/apidocs-4.10/apis/extractVolume.html
+type ExtractVolume struct {
+	ID     string `json:"id" doc:"the ID of the volume"`
+	Mode   string `json:"mode" doc:"the mode of extraction - HTTP_DOWNLOAD or FTP_UPLOAD" enum:"HTTP_DOWNLOAD,FTP_UPLOAD"`
+	ZoneID string `json:"zoneid" doc:"the ID of the zone where the volume is located"`
+	URL    string `json:"url,omitempty" doc:"the url to which the volume would be extracted"`
+}
+
+// ExtractedResource represents the result of an extraction (volume, template or ISO)
+type ExtractedResource struct {
+	AccountID        string `json:"accountid,omitempty" doc:"the account id to which the extracted object belongs"`
+	Created          string `json:"created,omitempty" doc:"the time and date the object was created"`
+	ExtractID        string `json:"extractId,omitempty" doc:"the upload id of extracted object"`
+	ExtractMode      string `json:"extractMode,omitempty" doc:"the mode of extraction - upload or download"`
+	ID               string `json:"id,omitempty" doc:"the id of extracted object"`
+	Name             string `json:"name,omitempty" doc:"the name of the extracted object"`
+	ResultString     string `json:"resultstring,omitempty"`
+	State            string `json:"state,omitempty" doc:"the state of the extracted object"`
+	Status           string `json:"status,omitempty" doc:"the status of the extraction"`
+	StorageType      string `json:"storagetype,omitempty" doc:"type of the storage"`
+	UploadPercentage int    `json:"uploadpercentage,omitempty" doc:"the percentage of the entity uploaded to the specified location"`
+	URL              string `json:"url,omitempty" doc:"if mode = upload then url of the uploaded entity. if mode = download the url from which the entity can be downloaded"`
+	ZoneID           string `json:"zoneid,omitempty" doc:"zone ID the object was extracted from"`
+	ZoneName         string `json:"zonename,omitempty" doc:"zone name the object was extracted from"`
+}
+
 // ResizeVolume (Async) resizes a volume
 //
 // CloudStack API: https://cloudstack.apache.org/api/apidocs-4.10/apis/resizeVolume.html
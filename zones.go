@@ -4,12 +4,23 @@ import (
 	"fmt"
 )
 
+// ResourceType returns the type of the resource
+func (*Zone) ResourceType() string {
+	return "Zone"
+}
+
+// ResourceID returns the identifier of the resource
+func (zone *Zone) ResourceID() string {
+	return zone.ID
+}
+
 // ListRequest builds the ListZones request
 func (zone *Zone) ListRequest() (ListCommand, error) {
 	req := &ListZones{
 		DomainID: zone.DomainID,
 		ID:       zone.ID,
 		Name:     zone.Name,
+		Tags:     tagFilter(zone.Tags),
 	}
 
 	return req, nil